@@ -8,7 +8,17 @@ import (
 
 // A CropBox is a bounding box that contains a strict subset of a board.
 type CropBox struct {
-	BBox         *BoundingBox
+	BBox *BoundingBox
+
+	// OriginalWidth and OriginalHeight are the dimensions of the uncropped
+	// board.
+	OriginalWidth  int
+	OriginalHeight int
+
+	// OriginalSize is the size of the uncropped board, which is the width for
+	// rectangular boards.
+	//
+	// Deprecated: Use OriginalWidth and OriginalHeight.
 	OriginalSize int
 }
 
@@ -69,45 +79,51 @@ const (
 //
 // Following the SGF covention, we consider the topleft to be 0,0
 func CropBoxFromPreset(p CroppingPreset, boardSize int) (*CropBox, error) {
-	bs := boardSize
+	return CropBoxFromPresetRect(p, boardSize, boardSize)
+}
 
-	halfInts := bs / 2
-	var minInts int
+// CropBoxFromPresetRect creates a cropping box from the original board width
+// and height, for rectangular boards. See CropBoxFromPreset.
+func CropBoxFromPresetRect(p CroppingPreset, width, height int) (*CropBox, error) {
+	halfWidth := width / 2
+	halfHeight := height / 2
 
-	top := minInts
-	left := minInts
-	bot := bs
-	right := bs
+	top := 0
+	left := 0
+	bot := height
+	right := width
 
 	switch p {
 	case All: // nothing to change
 	case Left:
-		right = halfInts + 1
+		right = halfWidth + 1
 	case Right:
-		left = halfInts - 1
+		left = halfWidth - 1
 	case Top:
-		bot = halfInts + 1
+		bot = halfHeight + 1
 	case Bottom:
-		top = halfInts - 1
+		top = halfHeight - 1
 	case TopLeft:
-		bot = halfInts + 1
-		right = halfInts + 2
+		bot = halfHeight + 1
+		right = halfWidth + 2
 	case TopRight:
-		bot = halfInts + 1
-		left = halfInts - 2
+		bot = halfHeight + 1
+		left = halfWidth - 2
 	case BottomLeft:
-		top = halfInts - 1
-		right = halfInts + 2
+		top = halfHeight - 1
+		right = halfWidth + 2
 	case BottomRight:
-		top = halfInts - 1
-		left = halfInts - 2
+		top = halfHeight - 1
+		left = halfWidth - 2
 	}
-	bb, err := New(point.New(top, left), point.New(bot, right))
+	bb, err := New(point.New(left, top), point.New(right, bot))
 	if err != nil {
 		return nil, fmt.Errorf("error while creating cropbox: %v", err)
 	}
 	return &CropBox{
-		BBox:         bb,
-		OriginalSize: boardSize,
+		BBox:           bb,
+		OriginalWidth:  width,
+		OriginalHeight: height,
+		OriginalSize:   width,
 	}, nil
 }
@@ -19,8 +19,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: All,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(0, 0), br: point.New(19, 19)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(0, 0), br: point.New(19, 19)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -28,8 +30,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: Left,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(0, 0), br: point.New(19, 10)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(0, 0), br: point.New(10, 19)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -37,8 +41,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: Right,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(0, 8), br: point.New(19, 19)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(8, 0), br: point.New(19, 19)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -46,8 +52,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: Top,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(0, 0), br: point.New(10, 19)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(0, 0), br: point.New(19, 10)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -55,8 +63,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: Bottom,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(8, 0), br: point.New(19, 19)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(0, 8), br: point.New(19, 19)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -64,8 +74,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: TopLeft,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(0, 0), br: point.New(10, 11)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(0, 0), br: point.New(11, 10)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -73,8 +85,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: TopRight,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(0, 7), br: point.New(10, 19)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(7, 0), br: point.New(19, 10)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -82,8 +96,10 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: BottomLeft,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(8, 0), br: point.New(19, 11)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(0, 8), br: point.New(11, 19)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 		{
@@ -91,17 +107,18 @@ func TestCropBoxFromPreset(t *testing.T) {
 			croppingPreset: BottomRight,
 			boardSize:      19,
 			exp: &CropBox{
-				BBox:         &BoundingBox{tl: point.New(8, 7), br: point.New(19, 19)},
-				OriginalSize: 19,
+				BBox:           &BoundingBox{tl: point.New(7, 8), br: point.New(19, 19)},
+				OriginalWidth:  19,
+				OriginalHeight: 19,
+				OriginalSize:   19,
 			},
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
 			got, err := CropBoxFromPreset(tc.croppingPreset, tc.boardSize)
-
 			if err != nil {
-				return
+				t.Fatal(err)
 			}
 
 			if !reflect.DeepEqual(got, tc.exp) {
@@ -112,3 +129,60 @@ func TestCropBoxFromPreset(t *testing.T) {
 	}
 
 }
+
+func TestCropBoxFromPresetRect(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		croppingPreset CroppingPreset
+		width, height  int
+		exp            *CropBox
+	}{
+		{
+			desc:           "All",
+			croppingPreset: All,
+			width:          19,
+			height:         13,
+			exp: &CropBox{
+				BBox:           &BoundingBox{tl: point.New(0, 0), br: point.New(19, 13)},
+				OriginalWidth:  19,
+				OriginalHeight: 13,
+				OriginalSize:   19,
+			},
+		},
+		{
+			desc:           "Top",
+			croppingPreset: Top,
+			width:          19,
+			height:         13,
+			exp: &CropBox{
+				BBox:           &BoundingBox{tl: point.New(0, 0), br: point.New(19, 7)},
+				OriginalWidth:  19,
+				OriginalHeight: 13,
+				OriginalSize:   19,
+			},
+		},
+		{
+			desc:           "BottomRight",
+			croppingPreset: BottomRight,
+			width:          19,
+			height:         13,
+			exp: &CropBox{
+				BBox:           &BoundingBox{tl: point.New(7, 5), br: point.New(19, 13)},
+				OriginalWidth:  19,
+				OriginalHeight: 13,
+				OriginalSize:   19,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := CropBoxFromPresetRect(tc.croppingPreset, tc.width, tc.height)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.exp) {
+				t.Errorf("got %v%v, expected %v%v", got.BBox.TopLeft(), got.BBox.BotRight(), tc.exp.BBox.TopLeft(), tc.exp.BBox.BotRight())
+			}
+		})
+	}
+}
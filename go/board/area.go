@@ -0,0 +1,105 @@
+package board
+
+import (
+	"sort"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// Area is a maximal orthogonally connected set of points that don't contain a
+// stone of the enclosing color. An area may contain empty points as well as
+// the other color's stones.
+type Area struct {
+	// Owner is the color that encloses the area.
+	Owner color.Color
+
+	// Points contains all the points in the area, sorted by x, then y.
+	Points []*point.Point
+
+	// Empty contains the empty points in the area, sorted by x, then y.
+	Empty []*point.Point
+
+	// Chains contains the owner's chains that border the area, ordered by
+	// their first stone.
+	Chains []*Chain
+}
+
+// AreaAt returns the area enclosed by color c that contains point pt, or nil
+// if pt is out of bounds or contains a stone of color c.
+func (b *Board) AreaAt(pt *point.Point, c color.Color) *Area {
+	if !b.inBounds(pt) || b.colorAt(pt) == c {
+		return nil
+	}
+	return b.areaAt(b.index(pt), toCell(c), newBitset(len(b.cells)), make([]*Chain, len(b.cells)))
+}
+
+// Areas partitions the points that don't contain stones of color c into the
+// areas enclosed by c, ordered by their first point. Areas that border the
+// same chain share the same *Chain.
+func (b *Board) Areas(c color.Color) []*Area {
+	owner := toCell(c)
+	explored := newBitset(len(b.cells))
+	chains := make([]*Chain, len(b.cells))
+
+	var out []*Area
+	for i, cl := range b.cells {
+		if cl != owner && !explored.has(i) {
+			out = append(out, b.areaAt(i, owner, explored, chains))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Points[0].Less(out[j].Points[0])
+	})
+	return out
+}
+
+// areaAt flood-fills the area enclosed by owner that contains index i,
+// marking its points in explored. The bordering chains are looked up in
+// chains, which maps each stone's index to its chain, and added to it as
+// they're found.
+func (b *Board) areaAt(i int, owner cell, explored bitset, chains []*Chain) *Area {
+	var empty []int
+	var borders []*Chain
+	bordered := make(map[*Chain]bool)
+
+	explored.add(i)
+	idxs := []int{i}
+	var nb [4]int
+	for k := 0; k < len(idxs); k++ {
+		cur := idxs[k]
+		if b.cells[cur] == emptyCell {
+			empty = append(empty, cur)
+		}
+		for _, n := range b.neighbors(cur, &nb) {
+			if b.cells[n] != owner {
+				if !explored.has(n) {
+					explored.add(n)
+					idxs = append(idxs, n)
+				}
+				continue
+			}
+			if chains[n] == nil {
+				ch := b.chainAt(n, newBitset(len(b.cells)))
+				for _, st := range ch.Stones {
+					chains[b.index(st)] = ch
+				}
+			}
+			if ch := chains[n]; !bordered[ch] {
+				bordered[ch] = true
+				borders = append(borders, ch)
+			}
+		}
+	}
+
+	a := &Area{
+		Owner:  owner.color(),
+		Points: b.points(idxs),
+		Empty:  b.points(empty),
+		Chains: borders,
+	}
+	point.SortList(a.Points)
+	point.SortList(a.Empty)
+	sortChains(a.Chains)
+	return a
+}
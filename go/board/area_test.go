@@ -0,0 +1,65 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestAreaAt(t *testing.T) {
+	b := fromRows([][]color.Color{
+		{"", "B", "", ""},
+		{"W", "B", "", ""},
+		{"B", "B", "", ""},
+		{"", "", "", ""}})
+
+	a := b.AreaAt(point.New(0, 0), color.Black)
+	if a == nil {
+		t.Fatal("got nil area, expected the corner")
+	}
+	expPoints := []*point.Point{point.New(0, 0), point.New(0, 1)}
+	if !cmp.Equal(a.Points, expPoints) {
+		t.Errorf("got points %v, expected %v", a.Points, expPoints)
+	}
+	expEmpty := []*point.Point{point.New(0, 0)}
+	if !cmp.Equal(a.Empty, expEmpty) {
+		t.Errorf("got empty points %v, expected %v", a.Empty, expEmpty)
+	}
+	if a.Owner != color.Black || len(a.Chains) != 1 || len(a.Chains[0].Stones) != 4 {
+		t.Errorf("got owner %v and chains %v, expected the black wall", a.Owner, a.Chains)
+	}
+
+	if a := b.AreaAt(point.New(1, 1), color.Black); a != nil {
+		t.Errorf("got area %v at a black stone, expected nil", a)
+	}
+	if a := b.AreaAt(point.New(4, 0), color.Black); a != nil {
+		t.Errorf("got area %v out of bounds, expected nil", a)
+	}
+}
+
+func TestAreas(t *testing.T) {
+	b := fromRows([][]color.Color{
+		{"", "B", "", ""},
+		{"W", "B", "", ""},
+		{"B", "B", "", ""},
+		{"", "", "", ""}})
+
+	areas := b.Areas(color.Black)
+	if len(areas) != 2 {
+		t.Fatalf("got %d areas, expected 2", len(areas))
+	}
+	if !areas[0].Points[0].Equal(point.New(0, 0)) || len(areas[1].Points) != 10 {
+		t.Errorf("got areas starting at %v and %v with %d and %d points, expected the corner first",
+			areas[0].Points[0], areas[1].Points[0], len(areas[0].Points), len(areas[1].Points))
+	}
+	if areas[0].Chains[0] != areas[1].Chains[0] {
+		t.Errorf("expected the areas to share the wall's *Chain")
+	}
+
+	whiteAreas := b.Areas(color.White)
+	if len(whiteAreas) != 1 {
+		t.Errorf("got %d white areas, expected 1", len(whiteAreas))
+	}
+}
@@ -0,0 +1,156 @@
+package board_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/sgf"
+)
+
+// testDatabase is the directory of SGF games used for benchmarks.
+const testDatabase = "../../test-database"
+
+// game contains the setup and main-line moves of a game.
+type game struct {
+	width, height int
+	placements    move.List
+	moves         move.List
+}
+
+// loadGames parses the games in the test database.
+func loadGames(b *testing.B) []*game {
+	files, err := os.ReadDir(testDatabase)
+	if err != nil {
+		b.Skipf("reading %s: %v", testDatabase, err)
+	}
+	var games []*game
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), ".sgf") {
+			continue
+		}
+		contents, err := os.ReadFile(filepath.Join(testDatabase, f.Name()))
+		if err != nil {
+			b.Fatal(err)
+		}
+		mt, err := sgf.Parse(string(contents))
+		if err != nil {
+			b.Fatalf("parsing %s: %v", f.Name(), err)
+		}
+		g := &game{placements: mt.Root.Placements}
+		g.width, g.height = mt.Root.GameInfo.Dimensions()
+		for n := mt.Root; n != nil; {
+			if n.Move != nil {
+				g.moves = append(g.moves, n.Move)
+			}
+			if len(n.Children) == 0 {
+				break
+			}
+			n = n.Children[0]
+		}
+		games = append(games, g)
+	}
+	if len(games) == 0 {
+		b.Skipf("no games in %s", testDatabase)
+	}
+	return games
+}
+
+// replay plays out the game on a new board, stopping at the first illegal
+// move. It returns the number of moves played.
+func replay(g *game) (*board.Board, int) {
+	bd := board.NewRect(g.width, g.height)
+	if err := bd.SetPlacements(g.placements); err != nil {
+		return bd, 0
+	}
+	for i, m := range g.moves {
+		if _, err := bd.PlaceStone(m); err != nil {
+			return bd, i
+		}
+	}
+	return bd, len(g.moves)
+}
+
+// countMoves returns the number of moves that can be replayed in the games.
+func countMoves(games []*game) int {
+	total := 0
+	for _, g := range games {
+		_, n := replay(g)
+		total += n
+	}
+	return total
+}
+
+func BenchmarkReplay(b *testing.B) {
+	games := loadGames(b)
+	moves := countMoves(games)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, g := range games {
+			replay(g)
+		}
+	}
+	b.ReportMetric(float64(moves), "moves/op")
+}
+
+func BenchmarkReplay_SuperkoAndHistory(b *testing.B) {
+	games := loadGames(b)
+	moves := countMoves(games)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, g := range games {
+			bd := board.NewRect(g.width, g.height)
+			if err := bd.SetPlacements(g.placements); err != nil {
+				continue
+			}
+			bd.SetRules(board.Rules{Ko: board.PositionalSuperko})
+			bd.EnableHistory()
+			for _, m := range g.moves {
+				if _, err := bd.PlaceStone(m); err != nil {
+					break
+				}
+			}
+		}
+	}
+	b.ReportMetric(float64(moves), "moves/op")
+}
+
+func BenchmarkLegalMoves(b *testing.B) {
+	games := loadGames(b)
+	var boards []*board.Board
+	for _, g := range games {
+		bd, _ := replay(g)
+		boards = append(boards, bd)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, bd := range boards {
+			bd.LegalMoves(color.Black)
+		}
+	}
+}
+
+func BenchmarkScore(b *testing.B) {
+	games := loadGames(b)
+	var boards []*board.Board
+	for _, g := range games {
+		bd, _ := replay(g)
+		boards = append(boards, bd)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, bd := range boards {
+			if _, err := bd.Score(nil, board.AreaScoring, 6.5); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
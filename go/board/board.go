@@ -1,7 +1,6 @@
 package board
 
 import (
-	"container/list"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,176 +13,234 @@ import (
 var (
 	InvalidBoardState = errors.New("invalid board state")
 	IllegalMove       = errors.New("illegal move")
+
+	// The following errors wrap IllegalMove and give the reason a move is
+	// illegal.
+	OutOfBoundsMove = fmt.Errorf("%w: out of bounds", IllegalMove)
+	OccupiedMove    = fmt.Errorf("%w: point occupied", IllegalMove)
+	SuicidalMove    = fmt.Errorf("%w: suicide", IllegalMove)
+	KoMove          = fmt.Errorf("%w: ko", IllegalMove)
+	SuperkoMove     = fmt.Errorf("%w: superko", IllegalMove)
 )
 
 // Board Contains the board, capturesStones, and ko
 // ko contains a point that is illegal to recapture due to Ko.
 type Board struct {
-	// The board, arranged in rows (rather than columns).
-	board [][]color.Color
+	// width and height are the dimensions of the board.
+	width  int
+	height int
+
+	// cells contains the contents of each point, arranged in rows: the point
+	// (x, y) is at index y*width + x.
+	cells []cell
 	ko    *point.Point
+
+	// blackPrisoners and whitePrisoners count the stones captured by Black and
+	// White respectively.
+	blackPrisoners int
+	whitePrisoners int
+
+	// stoneHash is the Zobrist hash of the stones on the board.
+	stoneHash uint64
+
+	// toPlay is the player to move next, if known.
+	toPlay color.Color
+
+	// rules contains the rules used for checking move legality.
+	rules Rules
+
+	// positions contains the hashes of previous positions, recorded only when a
+	// superko rule is used.
+	positions map[uint64]bool
+
+	// history records the placed moves so they can be undone, if enabled.
+	history *history
 }
 
 // New creates a new size x size board.
 func New(size int) *Board {
-	board := Board{
-		make([][]color.Color, size),
-		nil,
+	return NewRect(size, size)
+}
+
+// NewRect creates a new width x height board.
+func NewRect(width, height int) *Board {
+	return &Board{
+		width:  width,
+		height: height,
+		cells:  make([]cell, width*height),
 	}
+}
 
-	for i := 0; i < size; i++ {
-		board.board[i] = make([]color.Color, size)
+// Width returns the number of columns of the board.
+func (b *Board) Width() int {
+	return b.width
+}
+
+// Height returns the number of rows of the board.
+func (b *Board) Height() int {
+	return b.height
+}
+
+// Neighbors returns the orthogonal neighbors of point pt that are on the
+// board, or nil if pt is out of bounds.
+func (b *Board) Neighbors(pt *point.Point) []*point.Point {
+	if !b.inBounds(pt) {
+		return nil
 	}
-	return &board
+	var nb [4]int
+	return b.points(b.neighbors(b.index(pt), &nb))
 }
 
 // PlaceStone adds a stone to the board and removes captured stones (if any).
 // returns the captured stones, or err if any Go (baduk) rules were broken
+//
+// A pass move is always legal and clears the ko point.
 func (b *Board) PlaceStone(m *move.Move) (move.List, error) {
-	if !b.inBounds(m.Point()) {
-		return nil, fmt.Errorf("%w: move %v out of bounds for %dx%d board",
-			IllegalMove, m.Point(), len(b.board[0]), len(b.board))
+	if b.tracksPositions() {
+		b.initPositions(m.Color())
 	}
-	if b.colorAt(m.Point()) != color.Empty {
-		return nil, fmt.Errorf("%w: move %v already occupied", IllegalMove, m.Point())
+	entry := &historyEntry{
+		move:       m,
+		prevKo:     b.ko,
+		prevToPlay: b.toPlay,
+	}
+	if m.IsPass() {
+		b.ko = nil
+		b.toPlay = m.Color().Opposite()
+		if b.tracksPositions() {
+			entry.position = b.positionKey(b.toPlay, nil, nil)
+			entry.newPosition = b.addPosition(entry.position)
+		}
+		b.record(entry)
+		return nil, nil
 	}
 
-	b.setColor(m)
-
-	capturedStones := b.findCapturedGroups(m)
-	if len(capturedStones) == 0 && len(b.capturedStones(m.Point())) != 0 {
-		b.setColor(move.New(color.Empty, m.Point()))
-		return nil, fmt.Errorf("%w: move %v is suicidal", IllegalMove, m.Point())
+	capturedStones, err := b.evaluate(m)
+	if err != nil {
+		return nil, err
 	}
-	if len(capturedStones) == 1 {
-		if b.ko != nil && *(b.ko) == *(m.Point()) {
-			b.setColor(move.New(color.Empty, m.Point()))
-			return nil, fmt.Errorf("%w: %v is an illegal ko move", IllegalMove, m.Point())
-		}
-		b.ko = capturedStones[0]
+	if b.tracksPositions() {
+		entry.position = b.positionKey(m.Color().Opposite(), m, capturedStones)
+		entry.newPosition = b.addPosition(entry.position)
+	}
+	i := b.index(m.Point())
+	if len(capturedStones) == 1 && capturedStones[0] != i {
+		b.ko = b.point(capturedStones[0])
 	} else {
 		b.ko = nil
 	}
 
-	// convert the captured stones into Move objects for convience.
+	b.setCell(i, toCell(m.Color()))
+
+	// convert the captured stones into Move objects for convience. With
+	// suicide, these are the player's own stones.
 	var captured move.List
-	opp := m.Color().Opposite()
-	for _, pt := range capturedStones {
-		captured = append(captured, move.New(opp, pt))
+	for _, ci := range capturedStones {
+		captured = append(captured, move.New(b.cells[ci].color(), b.point(ci)))
 	}
 	captured.Sort()
 
 	b.removeCapturedStones(capturedStones)
-	return captured, nil
-}
-
-// findCapturedGroups returns the groups captured by *Move m.
-func (b *Board) findCapturedGroups(m *move.Move) []*point.Point {
-	pt := m.Point()
-
-	points := b.getNeighbors(pt)
-	capturedStones := make([]*point.Point, 0)
-	for _, point := range points {
-		if b.inBounds(point) {
-			capturedStones = append(capturedStones, b.capturedStones(point)...)
-		}
+	for _, mv := range captured {
+		b.addPrisoners(mv.Color().Opposite(), 1)
 	}
-	return capturedStones
+	b.toPlay = m.Color().Opposite()
+
+	entry.captured = copyMoves(captured)
+	entry.ko = b.ko
+	b.record(entry)
+	return captured, nil
 }
 
-// removeCapturedStones removes the captured stones from
-// the board.
-func (b *Board) removeCapturedStones(capturedStones []*point.Point) {
-	for _, point := range capturedStones {
-		b.setColor(move.New(color.Empty, point))
+// addPrisoners adds n prisoners to the count for the capturing color c.
+func (b *Board) addPrisoners(c color.Color, n int) {
+	switch c {
+	case color.Black:
+		b.blackPrisoners += n
+	case color.White:
+		b.whitePrisoners += n
 	}
 }
 
-// capturedStones returns the captured stones in group containing Point pt.
-// returns nil if no stones were captured.
-func (b *Board) capturedStones(pt *point.Point) []*point.Point {
-	stoneGroup, captured := b.getStoneGroup(pt)
-	if captured {
-		return stoneGroup
+// Prisoners returns the number of stones captured by the player with color c.
+func (b *Board) Prisoners(c color.Color) int {
+	switch c {
+	case color.Black:
+		return b.blackPrisoners
+	case color.White:
+		return b.whitePrisoners
 	}
-	return nil
+	return 0
 }
 
-// getStoneGroup returns all the points in a stone group containing point pt
-// and true if the group is captured
-func (b *Board) getStoneGroup(pt *point.Point) ([]*point.Point, bool) {
-	expanded := make(map[point.Point]bool)
-	captured := true
-	// current group color
-	c := b.colorAt(pt)
-
-	queue := list.New()
-	queue.PushBack(pt)
-	for queue.Len() > 0 {
-		e := queue.Front()
-		queue.Remove(e)
-		pt1, ok := e.Value.(*point.Point)
-		if !ok {
-			panic("e.Value was not of type point.Point")
-		}
-
-		if !b.inBounds(pt1) {
+// findCapturedGroups returns the stones captured by placing a stone with cell
+// c at index i: the stones of the opposing groups next to i whose only liberty
+// is i. The board isn't modified.
+func (b *Board) findCapturedGroups(i int, c cell) []int {
+	opp := c.opposite()
+
+	var capturedStones []int
+	var explored bitset
+	var nb [4]int
+	for _, n := range b.neighbors(i, &nb) {
+		if b.cells[n] != opp || (explored != nil && explored.has(n)) {
 			continue
-		} else if b.colorAt(pt1) == color.Empty {
-			captured = false
-		} else if b.colorAt(pt1) == c && !expanded[*pt1] {
-			expanded[*pt1] = true
-			points := b.getNeighbors(pt1)
-			for _, point := range points {
-				queue.PushBack(point)
-			}
+		}
+		if explored == nil {
+			explored = newBitset(len(b.cells))
+		}
+		start := len(capturedStones)
+		var hasLiberty bool
+		capturedStones, hasLiberty = b.group(n, i, explored, capturedStones)
+		if hasLiberty {
+			capturedStones = capturedStones[:start]
 		}
 	}
+	return capturedStones
+}
 
-	// The stones in this group
-	stoneGroup := make([]*point.Point, len(expanded))
-	i := 0
-	for key := range expanded {
-		stoneGroup[i] = point.New(key.X(), key.Y())
-		i++
+// removeCapturedStones removes the captured stones from
+// the board.
+func (b *Board) removeCapturedStones(capturedStones []int) {
+	for _, i := range capturedStones {
+		b.setCell(i, emptyCell)
 	}
-	return stoneGroup, captured
 }
 
 // inBounds returns true if x and y are in bounds
 // on the board, false otherwise.
 func (b *Board) inBounds(pt *point.Point) bool {
 	var x, y int = pt.X(), pt.Y()
-	return x < len(b.board[0]) && y < len(b.board) &&
+	return x < b.width && y < b.height &&
 		x >= 0 && y >= 0
 }
 
 // colorAt returns the color at point pt.
 func (b *Board) colorAt(pt *point.Point) color.Color {
-	var x, y int = pt.X(), pt.Y()
-	return b.board[y][x]
+	return b.cells[b.index(pt)].color()
 }
 
-// setColor sets the color m.Color at point m.Point.
+// setColor sets the color m.Color at point m.Point, updating the hash.
 func (b *Board) setColor(m *move.Move) {
-	var x, y int = m.Point().X(), m.Point().Y()
-	b.board[y][x] = m.Color()
+	b.setCell(b.index(m.Point()), toCell(m.Color()))
 }
 
-// getNeighbors returns a list of points neighboring point pt.
-// Neighboring points could be out of bounds.
-func (b *Board) getNeighbors(pt *point.Point) []*point.Point {
-	points := make([]*point.Point, 4)
-	points[0] = point.New(pt.X()+1, pt.Y())
-	points[1] = point.New(pt.X()-1, pt.Y())
-	points[2] = point.New(pt.X(), pt.Y()+1)
-	points[3] = point.New(pt.X(), pt.Y()-1)
-	return points
+// setCell sets the cell at index i, updating the hash.
+func (b *Board) setCell(i int, c cell) {
+	b.stoneHash ^= b.zobristCell(i, b.cells[i]) ^ b.zobristCell(i, c)
+	b.cells[i] = c
 }
 
 // SetPlacements force-places moves on the go-board, without performing capture
 // logic. If an illegal board position results, return an error.
+//
+// If history is enabled, the recorded moves are discarded, unless there are no
+// placements, in which case the board is unchanged.
 func (b *Board) SetPlacements(ml move.List) error {
+	if len(ml) == 0 {
+		return nil
+	}
+	b.clearHistory()
 
 	for _, m := range ml {
 		b.setColor(m)
@@ -191,18 +248,15 @@ func (b *Board) SetPlacements(ml move.List) error {
 
 	// Validate we have a valid board position -- i.e., one
 	// without captures lying on the board.
-	explored := make(map[point.Point]bool)
+	explored := newBitset(len(b.cells))
 	for _, m := range ml {
-		pt := m.Point()
-
-		if !explored[*pt] {
-			stoneGroup, captured := b.getStoneGroup(pt)
-			if captured {
-				return fmt.Errorf("%w: stones at points %v are captured", InvalidBoardState, stoneGroup)
-			}
-			for _, point := range stoneGroup {
-				explored[*point] = true
-			}
+		i := b.index(m.Point())
+		if b.cells[i] == emptyCell || explored.has(i) {
+			continue
+		}
+		stoneGroup, hasLiberty := b.group(i, -1, explored, nil)
+		if !hasLiberty {
+			return fmt.Errorf("%w: stones at points %v are captured", InvalidBoardState, b.points(stoneGroup))
 		}
 	}
 	return nil
@@ -216,15 +270,28 @@ func (b *Board) Ko() *point.Point {
 // Clone makes a board copy.
 func (b *Board) Clone() *Board {
 	newb := &Board{
-		ko:    b.ko,
-		board: make([][]color.Color, len(b.board)),
+		width:          b.width,
+		height:         b.height,
+		cells:          make([]cell, len(b.cells)),
+		ko:             b.ko,
+		blackPrisoners: b.blackPrisoners,
+		whitePrisoners: b.whitePrisoners,
+		stoneHash:      b.stoneHash,
+		toPlay:         b.toPlay,
+		rules:          b.rules,
 	}
-	for i, row := range b.board {
-		newRow := make([]color.Color, len(row))
-		for j, col := range row {
-			newRow[j] = col
+	copy(newb.cells, b.cells)
+	if b.positions != nil {
+		newb.positions = make(map[uint64]bool, len(b.positions))
+		for key := range b.positions {
+			newb.positions[key] = true
+		}
+	}
+	if b.history != nil {
+		newb.history = &history{
+			entries: append([]*historyEntry(nil), b.history.entries...),
+			next:    b.history.next,
 		}
-		newb.board[i] = newRow
 	}
 	return newb
 }
@@ -232,12 +299,9 @@ func (b *Board) Clone() *Board {
 // StoneState returns an array of all the current stone positions.
 func (b *Board) StoneState() move.List {
 	var moves move.List
-	for i := 0; i < len(b.board); i++ {
-		for j := 0; j < len(b.board[0]); j++ {
-			if b.board[i][j] != color.Empty {
-				moves = append(moves,
-					move.New(b.board[i][j], point.New(j, i)))
-			}
+	for i, c := range b.cells {
+		if c != emptyCell {
+			moves = append(moves, move.New(c.color(), b.point(i)))
 		}
 	}
 	return moves
@@ -245,11 +309,11 @@ func (b *Board) StoneState() move.List {
 
 // FullBoardState returns the full board state.
 func (b *Board) FullBoardState() [][]color.Color {
-	out := make([][]color.Color, len(b.board))
-	for i, row := range b.board {
-		out[i] = make([]color.Color, len(row))
-		for j, col := range row {
-			out[i][j] = col
+	out := make([][]color.Color, b.height)
+	for y := range out {
+		out[y] = make([]color.Color, b.width)
+		for x := range out[y] {
+			out[y][x] = b.cells[y*b.width+x].color()
 		}
 	}
 	return out
@@ -269,17 +333,18 @@ func (b *Board) FullBoardState() [][]color.Color {
 //             [B . W .]
 func (b *Board) String() string {
 	var sb strings.Builder
-	for i := 0; i < len(b.board); i++ {
+	for y := 0; y < b.height; y++ {
 		// To increase useability of this String function,
 		// color.Empty is converted from "" to ".".
-		str := make([]string, len(b.board[0]))
-		for j := 0; j < len(b.board[0]); j++ {
-			if b.ko != nil && b.ko.X() == j && b.ko.Y() == i {
-				str[j] = "*"
-			} else if b.board[i][j] == color.Empty {
-				str[j] = "."
+		str := make([]string, b.width)
+		for x := 0; x < b.width; x++ {
+			c := b.cells[y*b.width+x]
+			if b.ko != nil && b.ko.X() == x && b.ko.Y() == y {
+				str[x] = "*"
+			} else if c == emptyCell {
+				str[x] = "."
 			} else {
-				str[j] = string(b.board[i][j])
+				str[x] = string(c.color())
 			}
 		}
 		sb.WriteString(fmt.Sprintf("%v\n", str))
@@ -12,6 +12,23 @@ import (
 	"github.com/otrego/clamshell/go/point"
 )
 
+// fromRows creates a board from rows of colors.
+func fromRows(rows [][]color.Color) *Board {
+	b := NewRect(len(rows[0]), len(rows))
+	for y, row := range rows {
+		for x, c := range row {
+			b.setColor(move.New(c, point.New(x, y)))
+		}
+	}
+	return b
+}
+
+// withKo sets the ko point of board b and returns it.
+func withKo(b *Board, ko *point.Point) *Board {
+	b.ko = ko
+	return b
+}
+
 func TestNewBoard(t *testing.T) {
 	testCases := []struct {
 		desc string
@@ -36,8 +53,8 @@ func TestNewBoard(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
-			got1 := len(tc.b.board)
-			got2 := len(tc.b.board[0])
+			got1 := tc.b.Height()
+			got2 := tc.b.Width()
 			if got1 != tc.exp || got2 != tc.exp {
 				t.Errorf("got %dx%d, expected %v", got1, got2, tc.exp)
 			}
@@ -45,6 +62,79 @@ func TestNewBoard(t *testing.T) {
 	}
 }
 
+func TestNewRect(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		b         *Board
+		expWidth  int
+		expHeight int
+		expString string
+	}{
+		{
+			desc:      "square board",
+			b:         NewRect(3, 3),
+			expWidth:  3,
+			expHeight: 3,
+			expString: "[. . .]\n[. . .]\n[. . .]",
+		},
+		{
+			desc:      "wide board",
+			b:         NewRect(4, 2),
+			expWidth:  4,
+			expHeight: 2,
+			expString: "[. . . .]\n[. . . .]",
+		},
+		{
+			desc:      "tall board",
+			b:         NewRect(2, 3),
+			expWidth:  2,
+			expHeight: 3,
+			expString: "[. .]\n[. .]\n[. .]",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := tc.b.Width(); got != tc.expWidth {
+				t.Errorf("got width %d, expected %d", got, tc.expWidth)
+			}
+			if got := tc.b.Height(); got != tc.expHeight {
+				t.Errorf("got height %d, expected %d", got, tc.expHeight)
+			}
+			if got := tc.b.String(); got != tc.expString {
+				t.Errorf("got string %q, expected %q", got, tc.expString)
+			}
+		})
+	}
+}
+
+func TestPlaceStone_RectBounds(t *testing.T) {
+	b := NewRect(5, 3)
+	if _, err := b.PlaceStone(move.New(color.Black, point.New(4, 2))); err != nil {
+		t.Errorf("got error %v placing in the bottom right corner", err)
+	}
+	if _, err := b.PlaceStone(move.New(color.White, point.New(2, 4))); !errors.Is(err, OutOfBoundsMove) {
+		t.Errorf("got error %v, expected %v", err, OutOfBoundsMove)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	b := NewRect(5, 3)
+	testCases := []struct {
+		pt  *point.Point
+		exp []*point.Point
+	}{
+		{point.New(0, 0), []*point.Point{point.New(1, 0), point.New(0, 1)}},
+		{point.New(4, 1), []*point.Point{point.New(3, 1), point.New(4, 2), point.New(4, 0)}},
+		{point.New(2, 1), []*point.Point{point.New(3, 1), point.New(1, 1), point.New(2, 2), point.New(2, 0)}},
+		{point.New(5, 0), nil},
+	}
+	for _, tc := range testCases {
+		if got := b.Neighbors(tc.pt); !cmp.Equal(got, tc.exp) {
+			t.Errorf("Neighbors(%v) = %v, expected %v", tc.pt, got, tc.exp)
+		}
+	}
+}
+
 func TestString(t *testing.T) {
 
 	testCases := []struct {
@@ -67,7 +157,7 @@ func TestString(t *testing.T) {
 		},
 		{
 			desc: "some White and Black added 9x9 board",
-			b: &Board{[][]color.Color{{"", "", "", "", "", "", "B", "W", ""},
+			b: fromRows([][]color.Color{{"", "", "", "", "", "", "B", "W", ""},
 				{"B", "", "", "", "", "B", "W", "W", ""},
 				{"B", "", "", "W", "", "", "B", "W", ""},
 				{"W", "", "", "", "", "B", "B", "W", ""},
@@ -75,9 +165,7 @@ func TestString(t *testing.T) {
 				{"", "B", "", "", "", "", "W", "B", "B"},
 				{"", "", "W", "", "B", "", "W", "", ""},
 				{"", "", "", "", "", "", "", "", ""},
-				{"", "", "", "", "", "", "", "", ""}},
-				nil,
-			},
+				{"", "", "", "", "", "", "", "", ""}}),
 			exp: "[. . . . . . B W .]\n" +
 				"[B . . . . B W W .]\n" +
 				"[B . . W . . B W .]\n" +
@@ -115,7 +203,7 @@ func TestCapturedStones(t *testing.T) {
 		},
 		{
 			desc: "some White and Black added 9x9 board, no captures",
-			b: &Board{[][]color.Color{{"", "", "", "", "", "", "B", "W", ""},
+			b: fromRows([][]color.Color{{"", "", "", "", "", "", "B", "W", ""},
 				{"B", "", "", "", "", "B", "W", "W", ""},
 				{"B", "", "", "W", "", "", "B", "W", ""},
 				{"W", "", "", "", "", "B", "B", "W", ""},
@@ -123,15 +211,13 @@ func TestCapturedStones(t *testing.T) {
 				{"", "B", "", "", "", "", "W", "B", "B"},
 				{"", "", "W", "", "B", "", "W", "", ""},
 				{"", "", "", "", "", "", "", "", ""},
-				{"", "", "", "", "", "", "", "", ""}},
-				nil,
-			},
+				{"", "", "", "", "", "", "", "", ""}}),
 			pt:  point.New(5, 5),
 			exp: nil,
 		},
 		{
 			desc: "deep liberty",
-			b: &Board{[][]color.Color{{"", "", "", "", "", "", "", "", ""},
+			b: fromRows([][]color.Color{{"", "", "", "", "", "", "", "", ""},
 				{"", "B", "B", "B", "B", "B", "B", "", ""},
 				{"", "B", "W", "W", "W", "W", "W", "", ""},
 				{"", "B", "W", "B", "B", "B", "B", "", ""},
@@ -139,16 +225,20 @@ func TestCapturedStones(t *testing.T) {
 				{"", "B", "W", "B", "B", "W", "B", "", ""},
 				{"", "B", "W", "W", "W", "W", "B", "", ""},
 				{"", "B", "B", "B", "B", "B", "B", "", ""},
-				{"", "", "", "", "", "", "", "", ""}},
-				nil,
-			},
+				{"", "", "", "", "", "", "", "", ""}}),
 			pt:  point.New(4, 4),
 			exp: nil,
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
-			got := tc.b.capturedStones(tc.pt)
+			var got []*point.Point
+			if i := tc.b.index(tc.pt); tc.b.cells[i] != emptyCell {
+				stones, hasLiberty := tc.b.group(i, -1, newBitset(len(tc.b.cells)), nil)
+				if !hasLiberty {
+					got = tc.b.points(stones)
+				}
+			}
 			if fmt.Sprintf("%v", got) != fmt.Sprintf("%v", tc.exp) {
 				t.Errorf("got %v, but expected %v", got, tc.exp)
 			}
@@ -165,7 +255,7 @@ func TestRemoveCapturedStones(t *testing.T) {
 	}{
 		{
 			desc: "4 captures",
-			b: &Board{[][]color.Color{{"", "", "", "", "B", "", "", "", ""},
+			b: fromRows([][]color.Color{{"", "", "", "", "B", "", "", "", ""},
 				{"", "", "", "B", "W", "B", "", "", ""},
 				{"", "", "", "B", "W", "B", "", "", ""},
 				{"", "B", "B", "B", "W", "B", "B", "B", ""},
@@ -173,9 +263,7 @@ func TestRemoveCapturedStones(t *testing.T) {
 				{"", "B", "B", "B", "W", "B", "B", "B", ""},
 				{"", "", "", "B", "W", "B", "", "", ""},
 				{"", "", "", "B", "W", "B", "", "", ""},
-				{"", "", "", "", "B", "", "", "", ""}},
-				nil,
-			},
+				{"", "", "", "", "B", "", "", "", ""}}),
 			m: move.New(color.Black, point.New(4, 4)),
 			exp: "[. . . . B . . . .]\n" +
 				"[. . . B . B . . .]\n" +
@@ -190,7 +278,7 @@ func TestRemoveCapturedStones(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
-			capturedStones := tc.b.findCapturedGroups(tc.m)
+			capturedStones := tc.b.findCapturedGroups(tc.b.index(tc.m.Point()), toCell(tc.m.Color()))
 			tc.b.removeCapturedStones(capturedStones)
 			got := tc.b.String()
 			if fmt.Sprintf("%s", got) != fmt.Sprintf("%s", tc.exp) {
@@ -302,18 +390,16 @@ func TestPlaceStone(t *testing.T) {
 		},
 		{
 			desc: "successful added stone -- top left capture",
-			b: &Board{
-				board: [][]color.Color{
-					{"", "W", "B", "", "", "", "", "", ""},
-					{"", "B", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""}},
-			},
+			b: fromRows([][]color.Color{
+				{"", "W", "B", "", "", "", "", "", ""},
+				{"", "B", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""}}),
 			m: move.New(color.Black, point.New(0, 0)),
 			exp: "[B * B . . . . . .]\n" +
 				"[. B . . . . . . .]\n" +
@@ -328,18 +414,16 @@ func TestPlaceStone(t *testing.T) {
 		},
 		{
 			desc: "4 capture groups",
-			b: &Board{
-				board: [][]color.Color{
-					{"", "", "", "", "B", "", "", "", ""},
-					{"", "", "", "B", "W", "B", "", "", ""},
-					{"", "", "", "B", "W", "B", "", "", ""},
-					{"", "B", "B", "B", "W", "B", "B", "B", ""},
-					{"B", "W", "W", "W", "", "W", "W", "W", "B"},
-					{"", "B", "B", "B", "W", "B", "B", "B", ""},
-					{"", "", "", "B", "W", "B", "", "", ""},
-					{"", "", "", "B", "W", "B", "", "", ""},
-					{"", "", "", "", "B", "", "", "", ""}},
-			},
+			b: fromRows([][]color.Color{
+				{"", "", "", "", "B", "", "", "", ""},
+				{"", "", "", "B", "W", "B", "", "", ""},
+				{"", "", "", "B", "W", "B", "", "", ""},
+				{"", "B", "B", "B", "W", "B", "B", "B", ""},
+				{"B", "W", "W", "W", "", "W", "W", "W", "B"},
+				{"", "B", "B", "B", "W", "B", "B", "B", ""},
+				{"", "", "", "B", "W", "B", "", "", ""},
+				{"", "", "", "B", "W", "B", "", "", ""},
+				{"", "", "", "", "B", "", "", "", ""}}),
 			m: move.New(color.Black, point.New(4, 4)),
 			expCaptures: move.List{
 				move.New(color.White, point.New(1, 4)),
@@ -370,66 +454,57 @@ func TestPlaceStone(t *testing.T) {
 		},
 		{
 			desc: "test out of bounds",
-			b: &Board{
-				board: [][]color.Color{{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "B", "", "", "", ""},
-					{"", "", "", "B", "", "B", "", "", ""},
-					{"", "", "", "B", "B", "W", "", "", ""},
-					{"", "", "", "", "W", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""}},
-			},
+			b: fromRows([][]color.Color{{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "B", "", "", "", ""},
+				{"", "", "", "B", "", "B", "", "", ""},
+				{"", "", "", "B", "B", "W", "", "", ""},
+				{"", "", "", "", "W", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""}}),
 			m:      move.New(color.White, point.New(33, 4)),
 			expErr: IllegalMove,
 		},
 		{
 			desc: "test occupied",
-			b: &Board{
-				board: [][]color.Color{{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "B", "", "", "", ""},
-					{"", "", "", "B", "", "B", "", "", ""},
-					{"", "", "", "B", "B", "W", "", "", ""},
-					{"", "", "", "", "W", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""}},
-			},
+			b: fromRows([][]color.Color{{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "B", "", "", "", ""},
+				{"", "", "", "B", "", "B", "", "", ""},
+				{"", "", "", "B", "B", "W", "", "", ""},
+				{"", "", "", "", "W", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""}}),
 			m:      move.New(color.White, point.New(4, 3)),
 			expErr: IllegalMove,
 		},
 		{
 			desc: "test suicidal",
-			b: &Board{
-				board: [][]color.Color{{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "B", "", "", "", ""},
-					{"", "", "", "B", "", "B", "", "", ""},
-					{"", "", "", "B", "B", "W", "", "", ""},
-					{"", "", "", "", "W", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""}},
-			},
+			b: fromRows([][]color.Color{{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "B", "", "", "", ""},
+				{"", "", "", "B", "", "B", "", "", ""},
+				{"", "", "", "B", "B", "W", "", "", ""},
+				{"", "", "", "", "W", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""}}),
 			m:      move.New(color.White, point.New(4, 4)),
 			expErr: IllegalMove,
 		},
 		{
 			desc: "test ko",
-			b: &Board{
-				board: [][]color.Color{{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "B", "", "", "", ""},
-					{"", "", "", "B", "", "B", "", "", ""},
-					{"", "", "", "W", "B", "W", "", "", ""},
-					{"", "", "", "", "W", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""},
-					{"", "", "", "", "", "", "", "", ""}},
-				ko: point.New(4, 4),
-			},
+			b: withKo(fromRows([][]color.Color{{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "B", "", "", "", ""},
+				{"", "", "", "B", "", "B", "", "", ""},
+				{"", "", "", "W", "B", "W", "", "", ""},
+				{"", "", "", "", "W", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""},
+				{"", "", "", "", "", "", "", "", ""}}), point.New(4, 4)),
 			m:      move.New(color.White, point.New(4, 4)),
 			expErr: IllegalMove,
 		},
@@ -459,18 +534,15 @@ func TestPlaceStone(t *testing.T) {
 }
 
 func TestClone(t *testing.T) {
-	b := &Board{
-		board: [][]color.Color{{"", "", "", "", "", "", "", "", ""},
-			{"", "", "", "", "", "", "", "", ""},
-			{"", "", "", "", "", "", "", "", ""},
-			{"", "", "", "", "B", "", "", "", ""},
-			{"", "", "", "B", "", "B", "", "", ""},
-			{"", "", "", "W", "B", "W", "", "", ""},
-			{"", "", "", "", "W", "", "", "", ""},
-			{"", "", "", "", "", "", "", "", ""},
-			{"", "", "", "", "", "", "", "", ""}},
-		ko: point.New(4, 5),
-	}
+	b := withKo(fromRows([][]color.Color{{"", "", "", "", "", "", "", "", ""},
+		{"", "", "", "", "", "", "", "", ""},
+		{"", "", "", "", "", "", "", "", ""},
+		{"", "", "", "", "B", "", "", "", ""},
+		{"", "", "", "B", "", "B", "", "", ""},
+		{"", "", "", "W", "B", "W", "", "", ""},
+		{"", "", "", "", "W", "", "", "", ""},
+		{"", "", "", "", "", "", "", "", ""},
+		{"", "", "", "", "", "", "", "", ""}}), point.New(4, 5))
 
 	newb := b.Clone()
 
@@ -0,0 +1,49 @@
+// Package boardtest provides utilities for creating boards in tests.
+package boardtest
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// FromDiagram creates a board from rows of B, W, and . characters, where the
+// first row is the top of the board. The board is as wide as the first row.
+// The test fails if a row has the wrong width, contains other characters, or
+// if the diagram has captured stones.
+//
+//	b := boardtest.FromDiagram(t,
+//		".B.",
+//		"BW.",
+//		"...")
+func FromDiagram(t testing.TB, rows ...string) *board.Board {
+	t.Helper()
+	if len(rows) == 0 {
+		t.Fatal("board diagram has no rows")
+	}
+	b := board.NewRect(len(rows[0]), len(rows))
+	var ml move.List
+	for y, row := range rows {
+		if len(row) != len(rows[0]) {
+			t.Fatalf("board diagram row %d is %q, but expected %d points", y, row, len(rows[0]))
+		}
+		for x, ch := range row {
+			switch ch {
+			case 'B':
+				ml = append(ml, move.New(color.Black, point.New(x, y)))
+			case 'W':
+				ml = append(ml, move.New(color.White, point.New(x, y)))
+			case '.':
+			default:
+				t.Fatalf("board diagram row %d is %q, but expected only B, W, and . characters", y, row)
+			}
+		}
+	}
+	if err := b.SetPlacements(ml); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
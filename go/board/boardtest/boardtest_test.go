@@ -0,0 +1,16 @@
+package boardtest
+
+import "testing"
+
+func TestFromDiagram(t *testing.T) {
+	b := FromDiagram(t,
+		".B..",
+		"BW..",
+		"....")
+	exp := "[. B . .]\n" +
+		"[B W . .]\n" +
+		"[. . . .]"
+	if got := b.String(); got != exp {
+		t.Errorf("got board:\n%v, but expected:\n%v", got, exp)
+	}
+}
@@ -0,0 +1,144 @@
+package board
+
+import (
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// cell is the compact representation of the contents of a point.
+type cell uint8
+
+const (
+	emptyCell cell = iota
+	blackCell
+	whiteCell
+)
+
+// toCell converts a color to a cell.
+func toCell(c color.Color) cell {
+	switch c {
+	case color.Black:
+		return blackCell
+	case color.White:
+		return whiteCell
+	}
+	return emptyCell
+}
+
+// color converts the cell to a color.
+func (c cell) color() color.Color {
+	switch c {
+	case blackCell:
+		return color.Black
+	case whiteCell:
+		return color.White
+	}
+	return color.Empty
+}
+
+// opposite returns the cell for the opposing color, or emptyCell if the cell
+// is empty.
+func (c cell) opposite() cell {
+	switch c {
+	case blackCell:
+		return whiteCell
+	case whiteCell:
+		return blackCell
+	}
+	return emptyCell
+}
+
+// bitset is a set of cell indices, used to mark points while searching the
+// board.
+type bitset []uint64
+
+// newBitset creates a bitset that can hold the indices [0, n).
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+// has indicates whether index i is in the set.
+func (s bitset) has(i int) bool {
+	return s[i>>6]&(1<<(uint(i)&63)) != 0
+}
+
+// add adds index i to the set.
+func (s bitset) add(i int) {
+	s[i>>6] |= 1 << (uint(i) & 63)
+}
+
+// index returns the cell index for point pt, which must be in bounds.
+func (b *Board) index(pt *point.Point) int {
+	return pt.Y()*b.width + pt.X()
+}
+
+// point returns the point for cell index i.
+func (b *Board) point(i int) *point.Point {
+	return point.New(i%b.width, i/b.width)
+}
+
+// points returns the points for the cell indices.
+func (b *Board) points(idxs []int) []*point.Point {
+	if len(idxs) == 0 {
+		return nil
+	}
+	out := make([]*point.Point, len(idxs))
+	for j, i := range idxs {
+		out[j] = b.point(i)
+	}
+	return out
+}
+
+// neighbors stores the in-bounds neighbors of cell index i in nb and returns
+// them as a slice of nb. Passing an array from the caller's stack keeps
+// neighbor iteration allocation-free.
+func (b *Board) neighbors(i int, nb *[4]int) []int {
+	n := 0
+	x := i % b.width
+	if x+1 < b.width {
+		nb[n] = i + 1
+		n++
+	}
+	if x > 0 {
+		nb[n] = i - 1
+		n++
+	}
+	if i+b.width < len(b.cells) {
+		nb[n] = i + b.width
+		n++
+	}
+	if i >= b.width {
+		nb[n] = i - b.width
+		n++
+	}
+	return nb[:n]
+}
+
+// group appends the stones of the chain containing the stone at index i to
+// stones, marking them in seen. It also indicates whether the chain has a
+// liberty other than the point at index except (-1 for none).
+func (b *Board) group(i, except int, seen bitset, stones []int) ([]int, bool) {
+	c := b.cells[i]
+	start := len(stones)
+	stones = append(stones, i)
+	seen.add(i)
+
+	hasLiberty := false
+	var nb [4]int
+	for k := start; k < len(stones); k++ {
+		for _, n := range b.neighbors(stones[k], &nb) {
+			switch b.cells[n] {
+			case emptyCell:
+				if n != except {
+					hasLiberty = true
+				}
+			case c:
+				if !seen.has(n) {
+					seen.add(n)
+					stones = append(stones, n)
+				}
+			}
+		}
+	}
+	return stones, hasLiberty
+}
@@ -0,0 +1,137 @@
+package board
+
+import (
+	"sort"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// Chain is a group of orthogonally connected stones of the same color.
+type Chain struct {
+	// Color of the stones in the chain.
+	Color color.Color
+
+	// Stones contains the points of the stones in the chain.
+	Stones []*point.Point
+
+	// Liberties contains the empty points adjacent to the chain.
+	Liberties []*point.Point
+}
+
+// LibertyCount returns the number of liberties of the chain.
+func (c *Chain) LibertyCount() int {
+	return len(c.Liberties)
+}
+
+// InAtari indicates whether the chain has exactly one liberty.
+func (c *Chain) InAtari() bool {
+	return len(c.Liberties) == 1
+}
+
+// Contains indicates whether the chain contains a stone at point pt.
+func (c *Chain) Contains(pt *point.Point) bool {
+	for _, st := range c.Stones {
+		if st.Equal(pt) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChainAt returns the chain containing the stone at point pt, or nil if the
+// point is empty or out of bounds. Stones and liberties are sorted by x, then
+// y.
+func (b *Board) ChainAt(pt *point.Point) *Chain {
+	if !b.inBounds(pt) || b.colorAt(pt) == color.Empty {
+		return nil
+	}
+	return b.chainAt(b.index(pt), newBitset(len(b.cells)))
+}
+
+// chainAt returns the chain containing the stone at index i, marking its
+// stones in explored.
+func (b *Board) chainAt(i int, explored bitset) *Chain {
+	stones, _ := b.group(i, -1, explored, nil)
+
+	var libs []int
+	isLiberty := newBitset(len(b.cells))
+	var nb [4]int
+	for _, st := range stones {
+		for _, n := range b.neighbors(st, &nb) {
+			if b.cells[n] == emptyCell && !isLiberty.has(n) {
+				isLiberty.add(n)
+				libs = append(libs, n)
+			}
+		}
+	}
+
+	chain := &Chain{
+		Color:     b.cells[i].color(),
+		Stones:    b.points(stones),
+		Liberties: b.points(libs),
+	}
+	point.SortList(chain.Stones)
+	point.SortList(chain.Liberties)
+	return chain
+}
+
+// Liberties returns the liberties of the chain containing the stone at point
+// pt, or nil if there's no stone at pt.
+func (b *Board) Liberties(pt *point.Point) []*point.Point {
+	chain := b.ChainAt(pt)
+	if chain == nil {
+		return nil
+	}
+	return chain.Liberties
+}
+
+// LibertyCount returns the number of liberties of the chain containing the
+// stone at point pt, or 0 if there's no stone at pt.
+func (b *Board) LibertyCount(pt *point.Point) int {
+	return len(b.Liberties(pt))
+}
+
+// AdjacentChains returns the opposing chains that touch the chain containing
+// the stone at point pt, or nil if there's no stone at pt.
+func (b *Board) AdjacentChains(pt *point.Point) []*Chain {
+	if !b.inBounds(pt) || b.colorAt(pt) == color.Empty {
+		return nil
+	}
+	start := b.index(pt)
+	opp := b.cells[start].opposite()
+	stones, _ := b.group(start, -1, newBitset(len(b.cells)), nil)
+
+	var out []*Chain
+	explored := newBitset(len(b.cells))
+	var nb [4]int
+	for _, st := range stones {
+		for _, n := range b.neighbors(st, &nb) {
+			if b.cells[n] == opp && !explored.has(n) {
+				out = append(out, b.chainAt(n, explored))
+			}
+		}
+	}
+	sortChains(out)
+	return out
+}
+
+// Chains returns all the chains on the board, ordered by their first stone.
+func (b *Board) Chains() []*Chain {
+	var out []*Chain
+	explored := newBitset(len(b.cells))
+	for i, c := range b.cells {
+		if c != emptyCell && !explored.has(i) {
+			out = append(out, b.chainAt(i, explored))
+		}
+	}
+	sortChains(out)
+	return out
+}
+
+// sortChains sorts chains (in-place) by their first stone.
+func sortChains(chains []*Chain) {
+	sort.Slice(chains, func(i, j int) bool {
+		return chains[i].Stones[0].Less(chains[j].Stones[0])
+	})
+}
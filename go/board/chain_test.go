@@ -0,0 +1,117 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func chainBoard() *Board {
+	return fromRows([][]color.Color{
+		{"", "B", "W", "", ""},
+		{"B", "B", "W", "", ""},
+		{"", "W", "", "", ""},
+		{"", "", "", "", ""},
+		{"", "", "", "", ""}})
+}
+
+var (
+	blackChain = &Chain{
+		Color:     color.Black,
+		Stones:    []*point.Point{point.New(0, 1), point.New(1, 0), point.New(1, 1)},
+		Liberties: []*point.Point{point.New(0, 0), point.New(0, 2)},
+	}
+	whiteChainTop = &Chain{
+		Color:     color.White,
+		Stones:    []*point.Point{point.New(2, 0), point.New(2, 1)},
+		Liberties: []*point.Point{point.New(2, 2), point.New(3, 0), point.New(3, 1)},
+	}
+	whiteChainLeft = &Chain{
+		Color:     color.White,
+		Stones:    []*point.Point{point.New(1, 2)},
+		Liberties: []*point.Point{point.New(0, 2), point.New(1, 3), point.New(2, 2)},
+	}
+)
+
+func TestChainAt(t *testing.T) {
+	testCases := []struct {
+		desc string
+		pt   *point.Point
+		exp  *Chain
+	}{
+		{
+			desc: "black chain",
+			pt:   point.New(1, 1),
+			exp:  blackChain,
+		},
+		{
+			desc: "white chain",
+			pt:   point.New(2, 0),
+			exp:  whiteChainTop,
+		},
+		{
+			desc: "single stone",
+			pt:   point.New(1, 2),
+			exp:  whiteChainLeft,
+		},
+		{
+			desc: "empty point",
+			pt:   point.New(3, 3),
+		},
+		{
+			desc: "out of bounds",
+			pt:   point.New(5, 0),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			b := chainBoard()
+			got := b.ChainAt(tc.pt)
+			if !cmp.Equal(got, tc.exp, cmp.AllowUnexported(point.Point{})) {
+				t.Errorf("ChainAt(%v)=%v, but expected %v", tc.pt, got, tc.exp)
+			}
+			if exp := len(b.Liberties(tc.pt)); b.LibertyCount(tc.pt) != exp {
+				t.Errorf("LibertyCount(%v)=%d, but expected %d", tc.pt, b.LibertyCount(tc.pt), exp)
+			}
+		})
+	}
+}
+
+func TestChain_InAtari(t *testing.T) {
+	b := fromRows([][]color.Color{
+		{"W", "B", ""},
+		{"", "", ""},
+		{"", "", ""}})
+	if c := b.ChainAt(point.New(0, 0)); !c.InAtari() {
+		t.Errorf("expected chain %v to be in atari", c)
+	}
+	if c := b.ChainAt(point.New(1, 0)); c.InAtari() {
+		t.Errorf("expected chain %v to not be in atari", c)
+	}
+	if c := b.ChainAt(point.New(1, 0)); !c.Contains(point.New(1, 0)) || c.Contains(point.New(0, 1)) {
+		t.Errorf("chain %v contains the wrong stones", c)
+	}
+}
+
+func TestAdjacentChains(t *testing.T) {
+	b := chainBoard()
+	got := b.AdjacentChains(point.New(0, 1))
+	exp := []*Chain{whiteChainLeft, whiteChainTop}
+	if !cmp.Equal(got, exp, cmp.AllowUnexported(point.Point{})) {
+		t.Errorf("AdjacentChains()=%v, but expected %v", got, exp)
+	}
+	if got := b.AdjacentChains(point.New(4, 4)); got != nil {
+		t.Errorf("AdjacentChains() for an empty point=%v, but expected nil", got)
+	}
+}
+
+func TestChains(t *testing.T) {
+	b := chainBoard()
+	got := b.Chains()
+	exp := []*Chain{blackChain, whiteChainLeft, whiteChainTop}
+	if !cmp.Equal(got, exp, cmp.AllowUnexported(point.Point{})) {
+		t.Errorf("Chains()=%v, but expected %v", got, exp)
+	}
+}
@@ -0,0 +1,154 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// NoHistory indicates that there's no move to undo or redo.
+var NoHistory = errors.New("no move history")
+
+// historyEntry records a placed move along with the state needed to undo and
+// redo it without re-evaluating the move.
+type historyEntry struct {
+	// move is the move that was placed (possibly a pass).
+	move *move.Move
+
+	// captured contains the stones removed by the move. With suicide, these
+	// are the player's own stones.
+	captured move.List
+
+	// prevKo and ko are the ko points before and after the move.
+	prevKo *point.Point
+	ko     *point.Point
+
+	// prevToPlay is the player to move before the move.
+	prevToPlay color.Color
+
+	// position is the superko key of the position after the move, and
+	// newPosition indicates whether the move recorded it for the first time.
+	position    uint64
+	newPosition bool
+}
+
+// history is the list of moves placed on a board. The entries before next have
+// been applied; the entries from next onward have been undone and can be
+// redone.
+type history struct {
+	entries []*historyEntry
+	next    int
+}
+
+// EnableHistory starts recording the moves placed via PlaceStone, so that they
+// can be undone and redone. Undo and Redo only touch the points of the move and
+// its captures, which makes stepping back and forth through a game much
+// cheaper than cloning or replaying from the start.
+//
+// Moves placed before the history is enabled can't be undone. Calling
+// SetPlacements with any placements, or SetRules, clears the history.
+func (b *Board) EnableHistory() {
+	if b.history == nil {
+		b.history = &history{}
+	}
+}
+
+// HistoryEnabled indicates whether the board records its moves.
+func (b *Board) HistoryEnabled() bool {
+	return b.history != nil
+}
+
+// clearHistory discards all recorded moves, if history is enabled.
+func (b *Board) clearHistory() {
+	if b.history != nil {
+		b.history = &history{}
+	}
+}
+
+// record adds an entry for a newly placed move, discarding any undone moves.
+func (b *Board) record(e *historyEntry) {
+	if b.history == nil {
+		return
+	}
+	h := b.history
+	h.entries = append(h.entries[:h.next], e)
+	h.next++
+}
+
+// CanUndo indicates whether there's a recorded move to undo.
+func (b *Board) CanUndo() bool {
+	return b.history != nil && b.history.next > 0
+}
+
+// CanRedo indicates whether there's an undone move to redo.
+func (b *Board) CanRedo() bool {
+	return b.history != nil && b.history.next < len(b.history.entries)
+}
+
+// Undo takes back the last recorded move, restoring any stones it captured,
+// the prisoner counts, the ko point, and the player to move. It returns the
+// move that was undone, or an error wrapping NoHistory if there's nothing to
+// undo.
+func (b *Board) Undo() (*move.Move, error) {
+	if !b.CanUndo() {
+		return nil, fmt.Errorf("%w: nothing to undo", NoHistory)
+	}
+	h := b.history
+	e := h.entries[h.next-1]
+	h.next--
+
+	if !e.move.IsPass() {
+		for _, mv := range e.captured {
+			b.addPrisoners(mv.Color().Opposite(), -1)
+			if !mv.Point().Equal(e.move.Point()) {
+				b.setColor(mv)
+			}
+		}
+		b.setColor(move.New(color.Empty, e.move.Point()))
+	}
+	if e.newPosition && b.positions != nil {
+		delete(b.positions, e.position)
+	}
+	b.ko = e.prevKo
+	b.toPlay = e.prevToPlay
+	return e.move, nil
+}
+
+// Redo replays the last undone move and returns the stones it captured, as
+// with PlaceStone. It returns an error wrapping NoHistory if there's nothing
+// to redo.
+func (b *Board) Redo() (move.List, error) {
+	if !b.CanRedo() {
+		return nil, fmt.Errorf("%w: nothing to redo", NoHistory)
+	}
+	h := b.history
+	e := h.entries[h.next]
+	h.next++
+
+	if !e.move.IsPass() {
+		b.setColor(e.move)
+		for _, mv := range e.captured {
+			b.setColor(move.New(color.Empty, mv.Point()))
+			b.addPrisoners(mv.Color().Opposite(), 1)
+		}
+	}
+	if e.newPosition && b.positions != nil {
+		b.positions[e.position] = true
+	}
+	b.ko = e.ko
+	b.toPlay = e.move.Color().Opposite()
+	return copyMoves(e.captured), nil
+}
+
+// copyMoves returns a shallow copy of the move list, or nil if it's empty.
+func copyMoves(ml move.List) move.List {
+	if len(ml) == 0 {
+		return nil
+	}
+	out := make(move.List, len(ml))
+	copy(out, ml)
+	return out
+}
@@ -0,0 +1,214 @@
+package board
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// sameState indicates whether two boards have the same observable state.
+func sameState(a, b *Board) bool {
+	return reflect.DeepEqual(a.FullBoardState(), b.FullBoardState()) &&
+		reflect.DeepEqual(a.Ko(), b.Ko()) &&
+		a.Prisoners(color.Black) == b.Prisoners(color.Black) &&
+		a.Prisoners(color.White) == b.Prisoners(color.White) &&
+		a.ToPlay() == b.ToPlay() &&
+		a.Hash() == b.Hash()
+}
+
+func TestUndoRedo(t *testing.T) {
+	koBoard := func() *Board {
+		return fromRows([][]color.Color{
+			{"", "B", "W", "", ""},
+			{"B", "W", "", "W", ""},
+			{"", "B", "W", "", ""},
+			{"", "", "", "", ""},
+			{"", "", "", "", ""}})
+	}
+	suicideBoard := func() *Board {
+		return fromRows([][]color.Color{
+			{"", "W", ""},
+			{"W", "", ""},
+			{"", "", ""}})
+	}
+
+	testCases := []struct {
+		desc  string
+		b     *Board
+		rules Rules
+		moves move.List
+	}{
+		{
+			desc: "ko capture and pass",
+			b:    koBoard(),
+			moves: move.List{
+				move.New(color.Black, point.New(2, 1)),
+				move.NewPass(color.White),
+				move.New(color.Black, point.New(4, 4)),
+				move.New(color.White, point.New(1, 1)),
+			},
+		},
+		{
+			desc:  "positional superko",
+			b:     koBoard(),
+			rules: Rules{Ko: PositionalSuperko},
+			moves: move.List{
+				move.New(color.Black, point.New(2, 1)),
+				move.NewPass(color.White),
+				move.NewPass(color.Black),
+				move.New(color.White, point.New(3, 3)),
+			},
+		},
+		{
+			desc:  "suicide",
+			b:     suicideBoard(),
+			rules: Rules{AllowSuicide: true},
+			moves: move.List{
+				move.New(color.Black, point.New(0, 0)),
+				move.New(color.White, point.New(1, 1)),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			b := tc.b
+			b.SetRules(tc.rules)
+			b.EnableHistory()
+
+			// snapshots[i] is the state after the first i moves.
+			snapshots := []*Board{b.Clone()}
+			var captures []move.List
+			for _, m := range tc.moves {
+				captured, err := b.PlaceStone(m)
+				if err != nil {
+					t.Fatal(err)
+				}
+				captures = append(captures, captured)
+				snapshots = append(snapshots, b.Clone())
+			}
+
+			for i := len(tc.moves) - 1; i >= 0; i-- {
+				m, err := b.Undo()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(m, tc.moves[i]) {
+					t.Errorf("undo %d: got move %v, expected %v", i, m, tc.moves[i])
+				}
+				if !sameState(b, snapshots[i]) {
+					t.Errorf("undo %d: got board\n%v\nexpected\n%v", i, b, snapshots[i])
+				}
+			}
+			if b.CanUndo() {
+				t.Errorf("got CanUndo()=true after undoing all moves")
+			}
+
+			for i := range tc.moves {
+				captured, err := b.Redo()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(captured, captures[i]) {
+					t.Errorf("redo %d: got captures %v, expected %v", i, captured, captures[i])
+				}
+				if !sameState(b, snapshots[i+1]) {
+					t.Errorf("redo %d: got board\n%v\nexpected\n%v", i, b, snapshots[i+1])
+				}
+			}
+			if b.CanRedo() {
+				t.Errorf("got CanRedo()=true after redoing all moves")
+			}
+
+			// Undone positions must not count as repeated when the moves are
+			// played again.
+			for b.CanUndo() {
+				if _, err := b.Undo(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, m := range tc.moves {
+				if _, err := b.PlaceStone(m); err != nil {
+					t.Fatalf("replaying %v after undo: %v", m, err)
+				}
+			}
+		})
+	}
+}
+
+func TestUndo_PlaceStoneDiscardsRedo(t *testing.T) {
+	b := New(9)
+	b.EnableHistory()
+	for _, m := range (move.List{
+		move.New(color.Black, point.New(2, 2)),
+		move.New(color.White, point.New(6, 6)),
+	}) {
+		if _, err := b.PlaceStone(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := b.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if !b.CanRedo() {
+		t.Fatalf("got CanRedo()=false after undo")
+	}
+	if _, err := b.PlaceStone(move.New(color.White, point.New(3, 3))); err != nil {
+		t.Fatal(err)
+	}
+	if b.CanRedo() {
+		t.Errorf("got CanRedo()=true after placing a new move")
+	}
+	if _, err := b.Redo(); !errors.Is(err, NoHistory) {
+		t.Errorf("got error %v from Redo, expected %v", err, NoHistory)
+	}
+}
+
+func TestUndo_NoHistory(t *testing.T) {
+	b := New(9)
+	if _, err := b.PlaceStone(move.New(color.Black, point.New(2, 2))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Undo(); !errors.Is(err, NoHistory) {
+		t.Errorf("got error %v from Undo without history, expected %v", err, NoHistory)
+	}
+
+	b.EnableHistory()
+	if _, err := b.PlaceStone(move.New(color.White, point.New(3, 3))); err != nil {
+		t.Fatal(err)
+	}
+	// Setting no placements leaves the history alone.
+	if err := b.SetPlacements(nil); err != nil {
+		t.Fatal(err)
+	}
+	if !b.CanUndo() {
+		t.Errorf("got CanUndo()=false after SetPlacements without placements, expected true")
+	}
+	if err := b.SetPlacements(move.List{move.New(color.Black, point.New(4, 4))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Undo(); !errors.Is(err, NoHistory) {
+		t.Errorf("got error %v from Undo after SetPlacements, expected %v", err, NoHistory)
+	}
+}
+
+func TestUndo_Clone(t *testing.T) {
+	b := New(9)
+	b.EnableHistory()
+	if _, err := b.PlaceStone(move.New(color.Black, point.New(2, 2))); err != nil {
+		t.Fatal(err)
+	}
+	cl := b.Clone()
+	if _, err := cl.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.FullBoardState()[2][2]; got != color.Black {
+		t.Errorf("got %v at (2,2) on the original after undoing on the clone, expected %v", got, color.Black)
+	}
+	if !b.CanUndo() {
+		t.Errorf("got CanUndo()=false on the original after undoing on the clone")
+	}
+}
@@ -0,0 +1,109 @@
+package board
+
+import (
+	"fmt"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+)
+
+// IsLegal reports whether move m can be played, without modifying the board.
+// If the move is illegal, the error wraps IllegalMove and one of
+// OutOfBoundsMove, OccupiedMove, SuicidalMove, KoMove, or SuperkoMove to give
+// the reason. Pass moves are always legal.
+func (b *Board) IsLegal(m *move.Move) (bool, error) {
+	if m.IsPass() {
+		return true, nil
+	}
+	if _, err := b.evaluate(m); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// LegalMoves returns all the legal (non-pass) moves for the player with color
+// c, sorted by point.
+func (b *Board) LegalMoves(c color.Color) move.List {
+	var out move.List
+	for i := range b.cells {
+		m := move.New(c, b.point(i))
+		if ok, _ := b.IsLegal(m); ok {
+			out = append(out, m)
+		}
+	}
+	out.Sort()
+	return out
+}
+
+// evaluate checks whether the (non-pass) move m is legal, returning the cell
+// indices of the stones it would capture. For a suicidal move (when allowed),
+// the captured stones are the player's own group, including m's stone. The
+// board isn't modified.
+func (b *Board) evaluate(m *move.Move) ([]int, error) {
+	pt := m.Point()
+	if !b.inBounds(pt) {
+		return nil, fmt.Errorf("%w: move %v for %dx%d board",
+			OutOfBoundsMove, pt, b.Width(), b.Height())
+	}
+	i := b.index(pt)
+	if b.cells[i] != emptyCell {
+		return nil, fmt.Errorf("%w: move %v already occupied", OccupiedMove, pt)
+	}
+
+	c := toCell(m.Color())
+	captured := b.findCapturedGroups(i, c)
+	if len(captured) == 0 && !b.hasLibertyAfter(i, c) {
+		if !b.rules.AllowSuicide {
+			return nil, fmt.Errorf("%w: move %v is suicidal", SuicidalMove, pt)
+		}
+		captured = b.suicidalStones(i, c)
+	} else if len(captured) == 1 && b.ko != nil && b.ko.Equal(pt) {
+		return nil, fmt.Errorf("%w: %v is an illegal ko move", KoMove, pt)
+	}
+	if b.tracksPositions() && b.positions[b.positionKey(m.Color().Opposite(), m, captured)] {
+		return nil, fmt.Errorf("%w: %v repeats a previous position", SuperkoMove, pt)
+	}
+	return captured, nil
+}
+
+// hasLibertyAfter indicates whether a stone with cell c at index i would have
+// a liberty, ignoring captures: either an empty neighbor or a friendly
+// neighboring group with a liberty besides i.
+func (b *Board) hasLibertyAfter(i int, c cell) bool {
+	var explored bitset
+	var stones []int
+	var nb [4]int
+	for _, n := range b.neighbors(i, &nb) {
+		switch b.cells[n] {
+		case emptyCell:
+			return true
+		case c:
+			if explored == nil {
+				explored = newBitset(len(b.cells))
+			} else if explored.has(n) {
+				continue
+			}
+			var hasLiberty bool
+			stones, hasLiberty = b.group(n, i, explored, stones[:0])
+			if hasLiberty {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// suicidalStones returns the cell indices of the group that a stone with cell
+// c at index i would form: i plus the friendly groups next to it.
+func (b *Board) suicidalStones(i int, c cell) []int {
+	stones := []int{i}
+	explored := newBitset(len(b.cells))
+	explored.add(i)
+	var nb [4]int
+	for _, n := range b.neighbors(i, &nb) {
+		if b.cells[n] == c && !explored.has(n) {
+			stones, _ = b.group(n, -1, explored, stones)
+		}
+	}
+	return stones
+}
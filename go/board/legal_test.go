@@ -0,0 +1,144 @@
+package board
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestIsLegal(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		b      *Board
+		m      *move.Move
+		expErr error
+	}{
+		{
+			desc: "legal move",
+			b:    New(9),
+			m:    move.New(color.Black, point.New(4, 4)),
+		},
+		{
+			desc: "pass",
+			b:    New(9),
+			m:    move.NewPass(color.White),
+		},
+		{
+			desc:   "out of bounds",
+			b:      New(9),
+			m:      move.New(color.Black, point.New(9, 4)),
+			expErr: OutOfBoundsMove,
+		},
+		{
+			desc: "occupied",
+			b: fromRows([][]color.Color{
+				{"B", "", ""},
+				{"", "", ""},
+				{"", "", ""}}),
+			m:      move.New(color.White, point.New(0, 0)),
+			expErr: OccupiedMove,
+		},
+		{
+			desc: "single stone suicide",
+			b: fromRows([][]color.Color{
+				{"", "B", ""},
+				{"B", "", ""},
+				{"", "", ""}}),
+			m:      move.New(color.White, point.New(0, 0)),
+			expErr: SuicidalMove,
+		},
+		{
+			desc: "multi-stone suicide",
+			b: fromRows([][]color.Color{
+				{"W", "", "B", ""},
+				{"B", "B", "", ""},
+				{"", "", "", ""},
+				{"", "", "", ""}}),
+			m:      move.New(color.White, point.New(1, 0)),
+			expErr: SuicidalMove,
+		},
+		{
+			desc: "capture is not suicide",
+			b: fromRows([][]color.Color{
+				{"", "B", "W"},
+				{"B", "W", ""},
+				{"W", "", ""}}),
+			m: move.New(color.White, point.New(0, 0)),
+		},
+		{
+			desc: "ko",
+			b: withKo(fromRows([][]color.Color{
+				{"", "B", "W", ""},
+				{"B", "", "B", "W"},
+				{"", "B", "W", ""},
+				{"", "", "", ""}}), point.New(1, 1)),
+			m:      move.New(color.White, point.New(1, 1)),
+			expErr: KoMove,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			before := tc.b.Clone()
+			ok, err := tc.b.IsLegal(tc.m)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, but expected error %v", err, tc.expErr)
+			}
+			if ok != (tc.expErr == nil) {
+				t.Errorf("IsLegal(%v)=%v, but expected %v", tc.m, ok, tc.expErr == nil)
+			}
+			if err != nil && !errors.Is(err, IllegalMove) {
+				t.Errorf("got error %v, but expected it to wrap %v", err, IllegalMove)
+			}
+			if !reflect.DeepEqual(tc.b, before) {
+				t.Errorf("IsLegal modified the board; got:\n%v\nbut expected:\n%v", tc.b, before)
+			}
+
+			// PlaceStone should agree with IsLegal.
+			if _, err := tc.b.PlaceStone(tc.m); !errors.Is(err, tc.expErr) {
+				t.Errorf("PlaceStone got error %v, but expected error %v", err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestIsLegal_Superko(t *testing.T) {
+	b := fromRows([][]color.Color{
+		{"", "B", "W", ""},
+		{"B", "W", "", "W"},
+		{"", "B", "W", ""},
+		{"", "", "", ""}})
+	b.SetRules(Rules{Ko: PositionalSuperko})
+	for _, m := range (move.List{
+		move.New(color.Black, point.New(2, 1)),
+		move.NewPass(color.White),
+		move.NewPass(color.Black),
+	}) {
+		if _, err := b.PlaceStone(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := b.IsLegal(move.New(color.White, point.New(1, 1))); !errors.Is(err, SuperkoMove) {
+		t.Errorf("got error %v, but expected %v", err, SuperkoMove)
+	}
+}
+
+func TestLegalMoves(t *testing.T) {
+	b := fromRows([][]color.Color{
+		{"", "B", ""},
+		{"B", "B", ""},
+		{"", "", "W"}})
+	got := b.LegalMoves(color.White)
+	exp := move.List{
+		move.New(color.White, point.New(0, 2)),
+		move.New(color.White, point.New(1, 2)),
+		move.New(color.White, point.New(2, 0)),
+		move.New(color.White, point.New(2, 1)),
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("LegalMoves(W)=%v, but expected %v", got, exp)
+	}
+}
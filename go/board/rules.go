@@ -0,0 +1,97 @@
+package board
+
+import (
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+)
+
+// KoRule indicates which repeated board positions are forbidden.
+type KoRule int
+
+const (
+	// SimpleKo only forbids immediately recapturing a single stone ko.
+	SimpleKo KoRule = iota
+
+	// PositionalSuperko forbids any move that recreates a previous board
+	// position.
+	PositionalSuperko
+
+	// SituationalSuperko forbids any move that recreates a previous board
+	// position with the same player to move.
+	SituationalSuperko
+)
+
+// Rules contains the rule options that affect move legality. The zero value
+// uses simple ko and forbids suicide.
+type Rules struct {
+	// Ko is the rule used to forbid repeated positions.
+	Ko KoRule
+
+	// AllowSuicide indicates whether a player may play a move that leaves their
+	// own group without liberties. If allowed, the group is removed from the
+	// board and counted as captured by the opponent.
+	AllowSuicide bool
+}
+
+// SetRules sets the rules used when placing stones. When a superko rule is
+// used, the board records the positions that occur from the next move onward.
+// If history is enabled, the recorded moves are discarded.
+func (b *Board) SetRules(r Rules) {
+	b.rules = r
+	b.positions = nil
+	b.clearHistory()
+}
+
+// Rules returns the rules used by the board.
+func (b *Board) Rules() Rules {
+	return b.rules
+}
+
+// tracksPositions indicates whether the board needs to record previous
+// positions to enforce the ko rule.
+func (b *Board) tracksPositions() bool {
+	return b.rules.Ko == PositionalSuperko || b.rules.Ko == SituationalSuperko
+}
+
+// positionKey returns the hash of the position that results from adding the
+// stone for move m (if not nil) and removing the stones at the given cell
+// indices. For situational superko, the key includes the player to move next.
+func (b *Board) positionKey(toPlay color.Color, m *move.Move, removed []int) uint64 {
+	h := b.stoneHash
+	mi := -1
+	if m != nil {
+		mi = b.index(m.Point())
+		h ^= b.zobristCell(mi, toCell(m.Color()))
+	}
+	for _, i := range removed {
+		c := b.cells[i]
+		if i == mi {
+			// The stone for m is being removed, as with suicide.
+			c = toCell(m.Color())
+		}
+		h ^= b.zobristCell(i, c)
+	}
+	if b.rules.Ko == SituationalSuperko {
+		h ^= zobristToPlay(toPlay)
+	}
+	return h
+}
+
+// initPositions starts recording positions, if they're not already being
+// recorded, beginning with the current position.
+func (b *Board) initPositions(toPlay color.Color) {
+	if b.positions != nil {
+		return
+	}
+	b.positions = make(map[uint64]bool)
+	b.positions[b.positionKey(toPlay, nil, nil)] = true
+}
+
+// addPosition records a position key and indicates whether it's new.
+func (b *Board) addPosition(key uint64) bool {
+	if b.positions[key] {
+		return false
+	}
+	b.positions[key] = true
+	return true
+}
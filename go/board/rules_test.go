@@ -0,0 +1,237 @@
+package board
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestSuperko(t *testing.T) {
+	// Black captures the ko at {2,1}, both players pass (clearing the ko point),
+	// and then White retakes, recreating the starting position.
+	koBoard := func() *Board {
+		return fromRows([][]color.Color{
+			{"", "B", "W", "", ""},
+			{"B", "W", "", "W", ""},
+			{"", "B", "W", "", ""},
+			{"", "", "", "", ""},
+			{"", "", "", "", ""}})
+	}
+	moves := move.List{
+		move.New(color.Black, point.New(2, 1)),
+		move.NewPass(color.White),
+		move.NewPass(color.Black),
+		move.New(color.White, point.New(1, 1)),
+	}
+
+	testCases := []struct {
+		desc   string
+		rules  Rules
+		expErr error
+	}{
+		{
+			desc:  "simple ko allows retaking after passes",
+			rules: Rules{Ko: SimpleKo},
+		},
+		{
+			desc:   "positional superko forbids the repeated position",
+			rules:  Rules{Ko: PositionalSuperko},
+			expErr: IllegalMove,
+		},
+		{
+			desc:   "situational superko forbids the repeated position",
+			rules:  Rules{Ko: SituationalSuperko},
+			expErr: IllegalMove,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			b := koBoard()
+			b.SetRules(tc.rules)
+			var err error
+			for _, m := range moves {
+				if _, err = b.PlaceStone(m); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, but expected error %v", err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestSuperko_TripleKo(t *testing.T) {
+	// Three kos, which the players take in turn. The sixth move repeats the
+	// starting position, without breaking the simple ko rule.
+	tripleKo := func() *Board {
+		return fromRows([][]color.Color{
+			{"", "B", "W", "", ""},
+			{"B", "W", "", "W", ""},
+			{"", "B", "W", "", ""},
+			{"", "", "", "", ""},
+			{"", "W", "B", "", ""},
+			{"W", "B", "", "B", ""},
+			{"", "W", "B", "", ""},
+			{"", "", "", "", ""},
+			{"", "B", "W", "", ""},
+			{"B", "W", "", "W", ""},
+			{"", "B", "W", "", ""}})
+	}
+	moves := move.List{
+		move.New(color.Black, point.New(2, 1)),
+		move.New(color.White, point.New(2, 5)),
+		move.New(color.Black, point.New(2, 9)),
+		move.New(color.White, point.New(1, 1)),
+		move.New(color.Black, point.New(1, 5)),
+		move.New(color.White, point.New(1, 9)),
+	}
+
+	testCases := []struct {
+		desc   string
+		rules  Rules
+		expErr error
+	}{
+		{
+			desc:  "simple ko allows the cycle",
+			rules: Rules{Ko: SimpleKo},
+		},
+		{
+			desc:   "positional superko forbids the cycle",
+			rules:  Rules{Ko: PositionalSuperko},
+			expErr: SuperkoMove,
+		},
+		{
+			desc:   "situational superko forbids the cycle",
+			rules:  Rules{Ko: SituationalSuperko},
+			expErr: SuperkoMove,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			b := tripleKo()
+			b.SetRules(tc.rules)
+			for i, m := range moves {
+				_, err := b.PlaceStone(m)
+				if i < len(moves)-1 && err != nil {
+					t.Fatalf("move %d: %v", i, err)
+				}
+				if i == len(moves)-1 && !errors.Is(err, tc.expErr) {
+					t.Fatalf("got error %v for the last move, but expected error %v", err, tc.expErr)
+				}
+			}
+		})
+	}
+}
+
+func TestSuperko_IllegalMoveLeavesBoard(t *testing.T) {
+	b := fromRows([][]color.Color{
+		{"", "B", "W", ""},
+		{"B", "W", "", "W"},
+		{"", "B", "W", ""},
+		{"", "", "", ""}})
+	b.SetRules(Rules{Ko: PositionalSuperko})
+	for _, m := range (move.List{
+		move.New(color.Black, point.New(2, 1)),
+		move.NewPass(color.White),
+		move.NewPass(color.Black),
+	}) {
+		if _, err := b.PlaceStone(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp := b.String()
+	if _, err := b.PlaceStone(move.New(color.White, point.New(1, 1))); !errors.Is(err, IllegalMove) {
+		t.Fatalf("got error %v, but expected %v", err, IllegalMove)
+	}
+	if got := b.String(); got != exp {
+		t.Errorf("after illegal move, got board:\n%v, but expected:\n%v", got, exp)
+	}
+
+	// Other moves are still allowed.
+	if _, err := b.PlaceStone(move.New(color.White, point.New(3, 3))); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSuicide(t *testing.T) {
+	suicideBoard := func() *Board {
+		return fromRows([][]color.Color{
+			{"W", "", "B", ""},
+			{"B", "B", "", ""},
+			{"", "", "", ""},
+			{"", "", "", ""}})
+	}
+
+	testCases := []struct {
+		desc        string
+		b           *Board
+		rules       Rules
+		m           *move.Move
+		exp         string
+		expCaptures move.List
+		expErr      error
+	}{
+		{
+			desc:   "suicide forbidden",
+			rules:  Rules{},
+			m:      move.New(color.White, point.New(1, 0)),
+			expErr: SuicidalMove,
+		},
+		{
+			desc:  "multi-stone suicide allowed",
+			rules: Rules{AllowSuicide: true},
+			m:     move.New(color.White, point.New(1, 0)),
+			exp: "[. . B .]\n" +
+				"[B B . .]\n" +
+				"[. . . .]\n" +
+				"[. . . .]",
+			expCaptures: move.List{
+				move.New(color.White, point.New(0, 0)),
+				move.New(color.White, point.New(1, 0)),
+			},
+		},
+		{
+			desc:  "single stone suicide repeats the position under superko",
+			rules: Rules{Ko: PositionalSuperko, AllowSuicide: true},
+			b: fromRows([][]color.Color{
+				{"", "B", ""},
+				{"B", "", ""},
+				{"", "", ""}}),
+			m:      move.New(color.White, point.New(0, 0)),
+			expErr: SuperkoMove,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			b := tc.b
+			if b == nil {
+				b = suicideBoard()
+			}
+			b.SetRules(tc.rules)
+			capt, err := b.PlaceStone(tc.m)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, but expected error %v", err, tc.expErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(capt, tc.expCaptures) {
+				t.Errorf("got captures %v, but expected %v", capt, tc.expCaptures)
+			}
+			if got := b.String(); got != tc.exp {
+				t.Errorf("got board:\n%v, but expected board:\n%v", got, tc.exp)
+			}
+			if got := b.Prisoners(color.Black); got != len(tc.expCaptures) {
+				t.Errorf("got %d black prisoners, but expected %d", got, len(tc.expCaptures))
+			}
+			if b.Ko() != nil {
+				t.Errorf("got ko point %v after suicide, but expected none", b.Ko())
+			}
+		})
+	}
+}
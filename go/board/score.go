@@ -0,0 +1,206 @@
+package board
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// ScoringMethod indicates how a finished position is counted.
+type ScoringMethod int
+
+const (
+	// AreaScoring counts each player's living stones plus the empty points they
+	// surround. This is used by Chinese, Tromp-Taylor, and New Zealand rules.
+	AreaScoring ScoringMethod = iota
+
+	// TerritoryScoring counts the empty points each player surrounds plus the
+	// prisoners they've taken. This is used by Japanese and Korean rules.
+	TerritoryScoring
+)
+
+// Tally contains the per-player counts that make up a score.
+type Tally struct {
+	// Territory is the number of empty points surrounded only by this player's
+	// living stones, including the points vacated by removing dead stones.
+	Territory int
+
+	// Stones is the number of this player's living stones on the board.
+	Stones int
+
+	// Prisoners is the number of stones this player captured during the game
+	// plus the opponent's dead stones removed at the end of the game.
+	Prisoners int
+}
+
+// Score contains the result of scoring a board position.
+type Score struct {
+	// Method is the scoring method used to produce the score.
+	Method ScoringMethod
+
+	// Komi is the compensation added to White's total.
+	Komi float64
+
+	// Black and White contain the counts for each player.
+	Black Tally
+	White Tally
+
+	// Dame is the number of empty points that don't belong to either player.
+	Dame int
+}
+
+// Points returns the total points for the player with color c, including komi
+// for White.
+func (s *Score) Points(c color.Color) float64 {
+	var t Tally
+	var komi float64
+	switch c {
+	case color.Black:
+		t = s.Black
+	case color.White:
+		t = s.White
+		komi = s.Komi
+	default:
+		return 0
+	}
+	if s.Method == AreaScoring {
+		return float64(t.Territory+t.Stones) + komi
+	}
+	return float64(t.Territory+t.Prisoners) + komi
+}
+
+// Margin returns the komi-adjusted difference between Black's and White's
+// points. A positive margin means Black is ahead.
+func (s *Score) Margin() float64 {
+	return s.Points(color.Black) - s.Points(color.White)
+}
+
+// Winner returns the winning color, or color.Empty for a draw.
+func (s *Score) Winner() color.Color {
+	m := s.Margin()
+	if m > 0 {
+		return color.Black
+	} else if m < 0 {
+		return color.White
+	}
+	return color.Empty
+}
+
+// Result returns the score as an SGF result (RE) string. For example:
+//
+//	B+3.5
+//	W+22
+//	0      (a draw)
+func (s *Score) Result() string {
+	m := s.Margin()
+	if m < 0 {
+		m = -m
+	}
+	switch s.Winner() {
+	case color.Black:
+		return "B+" + strconv.FormatFloat(m, 'f', -1, 64)
+	case color.White:
+		return "W+" + strconv.FormatFloat(m, 'f', -1, 64)
+	}
+	return "0"
+}
+
+// Score counts the board position using the given scoring method and komi.
+// Dead contains the points of stones that are to be removed from the board
+// before counting; each dead point must contain a stone. The board itself is
+// not modified.
+//
+// Each region of connected empty (or dead) points that borders the living
+// stones of only one color is counted as that color's territory. Any other
+// region is dame.
+func (b *Board) Score(dead []*point.Point, method ScoringMethod, komi float64) (*Score, error) {
+	s := &Score{
+		Method: method,
+		Komi:   komi,
+	}
+	s.Black.Prisoners = b.blackPrisoners
+	s.White.Prisoners = b.whitePrisoners
+
+	isDead := newBitset(len(b.cells))
+	for _, pt := range dead {
+		if !b.inBounds(pt) {
+			return nil, fmt.Errorf("%w: dead stone %v out of bounds", InvalidBoardState, pt)
+		}
+		i := b.index(pt)
+		c := b.cells[i]
+		if c == emptyCell {
+			return nil, fmt.Errorf("%w: dead stone %v is an empty point", InvalidBoardState, pt)
+		}
+		if isDead.has(i) {
+			continue
+		}
+		isDead.add(i)
+		if c == blackCell {
+			s.White.Prisoners++
+		} else {
+			s.Black.Prisoners++
+		}
+	}
+
+	explored := newBitset(len(b.cells))
+	for i, c := range b.cells {
+		if c != emptyCell && !isDead.has(i) {
+			if c == blackCell {
+				s.Black.Stones++
+			} else {
+				s.White.Stones++
+			}
+			continue
+		}
+		if explored.has(i) {
+			continue
+		}
+		size, owner := b.openRegion(i, isDead, explored)
+		switch owner {
+		case blackCell:
+			s.Black.Territory += size
+		case whiteCell:
+			s.White.Territory += size
+		default:
+			s.Dame += size
+		}
+	}
+	return s, nil
+}
+
+// openRegion flood-fills the region of open (empty or dead) points containing
+// index i, marking them as explored. It returns the size of the region and the
+// cell of the bordering stones, or emptyCell if the region borders both colors
+// (or none).
+func (b *Board) openRegion(i int, isDead, explored bitset) (int, cell) {
+	var touchesBlack, touchesWhite bool
+
+	explored.add(i)
+	region := []int{i}
+	var nb [4]int
+	for k := 0; k < len(region); k++ {
+		for _, n := range b.neighbors(region[k], &nb) {
+			if explored.has(n) {
+				continue
+			}
+			c := b.cells[n]
+			if c == emptyCell || isDead.has(n) {
+				explored.add(n)
+				region = append(region, n)
+			} else if c == blackCell {
+				touchesBlack = true
+			} else {
+				touchesWhite = true
+			}
+		}
+	}
+
+	if touchesBlack && !touchesWhite {
+		return len(region), blackCell
+	} else if touchesWhite && !touchesBlack {
+		return len(region), whiteCell
+	}
+	return len(region), emptyCell
+}
@@ -0,0 +1,151 @@
+package board
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestScore(t *testing.T) {
+	split := func() *Board {
+		return fromRows([][]color.Color{
+			{"", "B", "", "W", ""},
+			{"", "B", "", "W", ""},
+			{"", "B", "", "W", ""},
+			{"", "B", "", "W", ""},
+			{"", "B", "", "W", ""}})
+	}
+	withDeadStone := func() *Board {
+		b := split()
+		b.setColor(move.New(color.White, point.New(0, 2)))
+		return b
+	}
+
+	testCases := []struct {
+		desc      string
+		b         *Board
+		dead      []*point.Point
+		method    ScoringMethod
+		komi      float64
+		expScore  *Score
+		expResult string
+		expErr    error
+	}{
+		{
+			desc:   "area scoring, no dead stones",
+			b:      split(),
+			method: AreaScoring,
+			komi:   6.5,
+			expScore: &Score{
+				Method: AreaScoring,
+				Komi:   6.5,
+				Black:  Tally{Territory: 5, Stones: 5},
+				White:  Tally{Territory: 5, Stones: 5},
+				Dame:   5,
+			},
+			expResult: "W+6.5",
+		},
+		{
+			desc:   "area scoring, draw",
+			b:      split(),
+			method: AreaScoring,
+			expScore: &Score{
+				Method: AreaScoring,
+				Black:  Tally{Territory: 5, Stones: 5},
+				White:  Tally{Territory: 5, Stones: 5},
+				Dame:   5,
+			},
+			expResult: "0",
+		},
+		{
+			desc:   "area scoring, dead stone",
+			b:      withDeadStone(),
+			dead:   []*point.Point{point.New(0, 2)},
+			method: AreaScoring,
+			komi:   0.5,
+			expScore: &Score{
+				Method: AreaScoring,
+				Komi:   0.5,
+				Black:  Tally{Territory: 5, Stones: 5, Prisoners: 1},
+				White:  Tally{Territory: 5, Stones: 5},
+				Dame:   5,
+			},
+			expResult: "W+0.5",
+		},
+		{
+			desc:   "territory scoring, dead stone",
+			b:      withDeadStone(),
+			dead:   []*point.Point{point.New(0, 2)},
+			method: TerritoryScoring,
+			komi:   0.5,
+			expScore: &Score{
+				Method: TerritoryScoring,
+				Komi:   0.5,
+				Black:  Tally{Territory: 5, Stones: 5, Prisoners: 1},
+				White:  Tally{Territory: 5, Stones: 5},
+				Dame:   5,
+			},
+			expResult: "B+0.5",
+		},
+		{
+			desc:   "dead stone on an empty point",
+			b:      split(),
+			dead:   []*point.Point{point.New(0, 2)},
+			method: AreaScoring,
+			expErr: InvalidBoardState,
+		},
+		{
+			desc:   "dead stone out of bounds",
+			b:      split(),
+			dead:   []*point.Point{point.New(10, 2)},
+			method: AreaScoring,
+			expErr: InvalidBoardState,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s, err := tc.b.Score(tc.dead, tc.method, tc.komi)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, but expected error %v", err, tc.expErr)
+			}
+			if err != nil {
+				return
+			}
+			if !cmp.Equal(s, tc.expScore) {
+				t.Errorf("got score %v, but expected %v. diff=%v", s, tc.expScore, cmp.Diff(s, tc.expScore))
+			}
+			if got := s.Result(); got != tc.expResult {
+				t.Errorf("got result %q, but expected %q", got, tc.expResult)
+			}
+		})
+	}
+}
+
+func TestPrisoners(t *testing.T) {
+	b := New(9)
+	ml := move.List{
+		move.New(color.Black, point.New(0, 0)),
+		move.New(color.White, point.New(1, 0)),
+		move.New(color.Black, point.New(2, 0)),
+		move.New(color.White, point.New(5, 5)),
+		move.New(color.Black, point.New(1, 1)),
+	}
+	for _, m := range ml {
+		if _, err := b.PlaceStone(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := b.Prisoners(color.Black); got != 1 {
+		t.Errorf("got %d black prisoners, but expected 1", got)
+	}
+	if got := b.Prisoners(color.White); got != 0 {
+		t.Errorf("got %d white prisoners, but expected 0", got)
+	}
+	if got := b.Clone().Prisoners(color.Black); got != 1 {
+		t.Errorf("got %d black prisoners after clone, but expected 1", got)
+	}
+}
@@ -0,0 +1,37 @@
+package board
+
+import (
+	"github.com/otrego/clamshell/go/point"
+)
+
+// Transform returns a new width x height board with each stone of b moved to
+// the point given by fn. If swapColors is set, the colors of the stones, the
+// prisoner counts, and the player to move are swapped. The ko point and the
+// rules are carried over, but previous positions (for superko) and the move
+// history are not.
+//
+// fn must map the points of b one-to-one onto the new board, as with the
+// rotations and reflections in the symmetry package.
+func (b *Board) Transform(width, height int, fn func(*point.Point) *point.Point, swapColors bool) *Board {
+	nb := NewRect(width, height)
+	for i, c := range b.cells {
+		if c == emptyCell {
+			continue
+		}
+		if swapColors {
+			c = c.opposite()
+		}
+		nb.setCell(nb.index(fn(b.point(i))), c)
+	}
+	if b.ko != nil {
+		nb.ko = fn(b.ko)
+	}
+	nb.blackPrisoners, nb.whitePrisoners = b.blackPrisoners, b.whitePrisoners
+	nb.toPlay = b.toPlay
+	if swapColors {
+		nb.blackPrisoners, nb.whitePrisoners = nb.whitePrisoners, nb.blackPrisoners
+		nb.toPlay = nb.toPlay.Opposite()
+	}
+	nb.rules = b.rules
+	return nb
+}
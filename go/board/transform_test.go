@@ -0,0 +1,37 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestTransform(t *testing.T) {
+	b := withKo(fromRows([][]color.Color{
+		{"B", "W", ""},
+		{"", "", ""}}), point.New(2, 0))
+	b.blackPrisoners = 2
+	b.SetToPlay(color.White)
+
+	transpose := func(pt *point.Point) *point.Point {
+		return point.New(pt.Y(), pt.X())
+	}
+	got := b.Transform(2, 3, transpose, true)
+
+	exp := "[W .]\n" +
+		"[B .]\n" +
+		"[* .]"
+	if got.String() != exp {
+		t.Errorf("got board\n%v\nexpected\n%v", got, exp)
+	}
+	if got.Prisoners(color.White) != 2 || got.Prisoners(color.Black) != 0 {
+		t.Errorf("got prisoners B=%d W=%d, expected B=0 W=2", got.Prisoners(color.Black), got.Prisoners(color.White))
+	}
+	if got.ToPlay() != color.Black {
+		t.Errorf("got to play %v, expected %v", got.ToPlay(), color.Black)
+	}
+	if b.String() != "[B W *]\n[. . .]" {
+		t.Errorf("the original board was modified:\n%v", b)
+	}
+}
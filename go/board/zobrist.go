@@ -0,0 +1,77 @@
+package board
+
+import (
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// Zobrist keys are derived from the inputs with a fixed mixing function
+// (splitmix64) rather than from a random table, so that hashes are stable
+// across runs and don't limit the board size.
+const (
+	zobristStoneTag  uint64 = 1 << 56
+	zobristKoTag     uint64 = 2 << 56
+	zobristToPlayTag uint64 = 3 << 56
+)
+
+// splitmix64 is the finalizer of the splitmix64 generator, which maps each
+// input to a well-distributed 64-bit value.
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// zobristStone returns the key for a stone of color c at (x, y). Empty points
+// have no key.
+func zobristStone(x, y int, c color.Color) uint64 {
+	if c == color.Empty {
+		return 0
+	}
+	return splitmix64(zobristStoneTag | uint64(x)<<32 | uint64(y)<<8 | uint64(c.Ordinal()))
+}
+
+// zobristCell returns the key for cell c at index i.
+func (b *Board) zobristCell(i int, c cell) uint64 {
+	if c == emptyCell {
+		return 0
+	}
+	return zobristStone(i%b.width, i/b.width, c.color())
+}
+
+// zobristKo returns the key for the ko point, if any.
+func zobristKo(pt *point.Point) uint64 {
+	if pt == nil {
+		return 0
+	}
+	return splitmix64(zobristKoTag | uint64(pt.X())<<32 | uint64(pt.Y())<<8)
+}
+
+// zobristToPlay returns the key for the player to move, if known.
+func zobristToPlay(c color.Color) uint64 {
+	if c == color.Empty {
+		return 0
+	}
+	return splitmix64(zobristToPlayTag | uint64(c.Ordinal()))
+}
+
+// Hash returns a 64-bit Zobrist hash of the position, including the stones,
+// the player to move, and the ko point. Equal positions reached by different
+// move orders have equal hashes. The hash is updated incrementally as stones
+// are placed and captured.
+func (b *Board) Hash() uint64 {
+	return b.stoneHash ^ zobristToPlay(b.toPlay) ^ zobristKo(b.ko)
+}
+
+// ToPlay returns the player to move next, or color.Empty if unknown. The player
+// to move is set by PlaceStone to the opponent of the player who moved.
+func (b *Board) ToPlay() color.Color {
+	return b.toPlay
+}
+
+// SetToPlay sets the player to move next. This is useful for positions created
+// via SetPlacements, such as problems.
+func (b *Board) SetToPlay(c color.Color) {
+	b.toPlay = c
+}
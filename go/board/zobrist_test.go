@@ -0,0 +1,148 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestHash(t *testing.T) {
+	play := func(b *Board, ml move.List) *Board {
+		for _, m := range ml {
+			if _, err := b.PlaceStone(m); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return b
+	}
+
+	testCases := []struct {
+		desc    string
+		a       *Board
+		b       *Board
+		expSame bool
+	}{
+		{
+			desc:    "empty boards",
+			a:       New(9),
+			b:       New(9),
+			expSame: true,
+		},
+		{
+			desc: "transposition",
+			a: play(New(9), move.List{
+				move.New(color.Black, point.New(2, 2)),
+				move.New(color.White, point.New(6, 6)),
+				move.New(color.Black, point.New(2, 6)),
+			}),
+			b: play(New(9), move.List{
+				move.New(color.Black, point.New(2, 6)),
+				move.New(color.White, point.New(6, 6)),
+				move.New(color.Black, point.New(2, 2)),
+			}),
+			expSame: true,
+		},
+		{
+			desc: "placements and moves",
+			a: play(New(9), move.List{
+				move.New(color.Black, point.New(2, 2)),
+				move.NewPass(color.White),
+				move.New(color.Black, point.New(2, 6)),
+			}),
+			b: func() *Board {
+				b := New(9)
+				if err := b.SetPlacements(move.List{
+					move.New(color.Black, point.New(2, 2)),
+					move.New(color.Black, point.New(2, 6)),
+				}); err != nil {
+					t.Fatal(err)
+				}
+				b.SetToPlay(color.White)
+				return b
+			}(),
+			expSame: true,
+		},
+		{
+			desc: "captured stones are removed from the hash",
+			a: play(New(9), move.List{
+				move.New(color.Black, point.New(0, 0)),
+				move.New(color.White, point.New(0, 1)),
+				move.New(color.Black, point.New(5, 5)),
+				move.New(color.White, point.New(1, 0)),
+				move.New(color.Black, point.New(6, 6)),
+			}),
+			b: play(New(9), move.List{
+				move.New(color.White, point.New(0, 1)),
+				move.New(color.Black, point.New(5, 5)),
+				move.New(color.White, point.New(1, 0)),
+				move.New(color.Black, point.New(6, 6)),
+			}),
+			expSame: true,
+		},
+		{
+			desc: "different player to move",
+			a: play(New(9), move.List{
+				move.New(color.Black, point.New(2, 2)),
+			}),
+			b: func() *Board {
+				b := play(New(9), move.List{
+					move.New(color.Black, point.New(2, 2)),
+				})
+				b.SetToPlay(color.Black)
+				return b
+			}(),
+			expSame: false,
+		},
+		{
+			desc: "different ko point",
+			a: withKo(fromRows([][]color.Color{
+				{"", "B", "W", ""},
+				{"B", "", "B", "W"},
+				{"", "B", "W", ""},
+				{"", "", "", ""}}), point.New(1, 1)),
+			b: fromRows([][]color.Color{
+				{"", "B", "W", ""},
+				{"B", "", "B", "W"},
+				{"", "B", "W", ""},
+				{"", "", "", ""}}),
+			expSame: false,
+		},
+		{
+			desc: "different stones",
+			a: play(New(9), move.List{
+				move.New(color.Black, point.New(2, 2)),
+			}),
+			b: play(New(9), move.List{
+				move.New(color.Black, point.New(2, 3)),
+			}),
+			expSame: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ha, hb := tc.a.Hash(), tc.b.Hash()
+			if (ha == hb) != tc.expSame {
+				t.Errorf("got hashes %x and %x for boards:\n%v\nand\n%v\nbut expected same=%v", ha, hb, tc.a, tc.b, tc.expSame)
+			}
+			if got := tc.a.Clone().Hash(); got != ha {
+				t.Errorf("got hash %x for clone, but expected %x", got, ha)
+			}
+		})
+	}
+}
+
+func TestHash_Stable(t *testing.T) {
+	b := New(19)
+	if got := b.Hash(); got != 0 {
+		t.Errorf("got hash %x for the empty board, but expected 0", got)
+	}
+	if _, err := b.PlaceStone(move.New(color.Black, point.New(3, 3))); err != nil {
+		t.Fatal(err)
+	}
+	var exp uint64 = 0xf941c3a4d729e92a
+	if got := b.Hash(); got != exp {
+		t.Errorf("got hash %#x, but expected %#x", got, exp)
+	}
+}
@@ -54,6 +54,9 @@ func FromSGFProp(prop string) (Color, error) {
 		return Black, nil
 	case "W", "AW":
 		return White, nil
+	case "AE":
+		// AE clears points, so the placements are empty.
+		return Empty, nil
 	default:
 		return Empty, fmt.Errorf("%w: converting property %q", ErrColorConversion, prop)
 	}
@@ -74,6 +74,12 @@ func TestFromSGFProp(t *testing.T) {
 			want:       White,
 			expErrType: nil,
 		},
+		{
+			desc:       "AE=>Empty",
+			in:         "AE",
+			want:       Empty,
+			expErrType: nil,
+		},
 		{
 			desc:       "empty=>empty",
 			in:         "",
@@ -0,0 +1,27 @@
+// Package deadstone estimates which stones are dead at the end of a game, so
+// that games that end without explicit dead-stone marking can be scored.
+package deadstone
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// Estimator estimates the dead stones of a finished game.
+type Estimator interface {
+	// DeadStones returns the points of the stones estimated to be dead, sorted
+	// by x, then y. The result can be passed directly to board.Score. The
+	// board isn't modified.
+	DeadStones(b *board.Board) ([]*point.Point, error)
+}
+
+// Stones returns the stones of the chains, sorted by x, then y. Estimators
+// that decide chain-by-chain use this to build their result.
+func Stones(chains []*board.Chain) []*point.Point {
+	var out []*point.Point
+	for _, ch := range chains {
+		out = append(out, ch.Stones...)
+	}
+	point.SortList(out)
+	return out
+}
@@ -0,0 +1,213 @@
+package deadstone
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/life"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+const (
+	// DefaultRadius is the default distance over which a stone projects
+	// influence.
+	DefaultRadius = 3
+
+	// DefaultThreshold is the default score below which a chain is dead.
+	DefaultThreshold = 0.0
+)
+
+// Heuristic estimates dead stones without reading, by weighing each chain
+// against the influence of the stones around it.
+//
+// Chains that are unconditionally alive, in seki, or that have two or more
+// real eyes are always alive. For each other chain, the influence of the
+// living stones is averaged over the empty points that the chain can reach
+// without crossing a living opponent stone, starting from its liberties. A
+// chain whose reachable space is dominated by the opponent (scoring below
+// -Threshold) is dead.
+//
+// Chains are removed one at a time, weakest first, and the influence is
+// recomputed after each removal, so that a dead chain doesn't help its
+// neighbors look alive.
+type Heuristic struct {
+	// Radius is the Manhattan distance over which a stone projects influence.
+	// The influence of a stone falls off linearly with distance.
+	Radius int
+
+	// Threshold is how strongly the opponent must dominate a chain's
+	// surroundings for it to be dead, as an average influence per point.
+	Threshold float64
+}
+
+var _ Estimator = (*Heuristic)(nil)
+
+// NewHeuristic creates a Heuristic estimator with the default radius and
+// threshold.
+func NewHeuristic() *Heuristic {
+	return &Heuristic{Radius: DefaultRadius, Threshold: DefaultThreshold}
+}
+
+// DeadStones returns the stones estimated to be dead.
+func (h *Heuristic) DeadStones(b *board.Board) ([]*point.Point, error) {
+	e := &estimate{
+		h:      h,
+		b:      b,
+		chains: b.Chains(),
+		living: b.Clone(),
+	}
+	alive := e.alive()
+	dead := make([]bool, len(e.chains))
+
+	for {
+		infl := e.influence(dead)
+		weakest, weakestScore := -1, -h.Threshold
+		for i, ch := range e.chains {
+			if alive[i] || dead[i] {
+				continue
+			}
+			if s := e.score(ch, infl); s < weakestScore {
+				weakest, weakestScore = i, s
+			}
+		}
+		if weakest < 0 {
+			break
+		}
+		dead[weakest] = true
+		var removed move.List
+		for _, st := range e.chains[weakest].Stones {
+			removed = append(removed, move.New(color.Empty, st))
+		}
+		if err := e.living.SetPlacements(removed); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []*board.Chain
+	for i, ch := range e.chains {
+		if dead[i] {
+			out = append(out, ch)
+		}
+	}
+	return Stones(out), nil
+}
+
+// estimate contains the state for a single run of the heuristic.
+type estimate struct {
+	h      *Heuristic
+	b      *board.Board
+	chains []*board.Chain
+
+	// living is a copy of the board with the chains found dead so far
+	// removed.
+	living *board.Board
+}
+
+// alive marks the chains that are known to be alive.
+func (e *estimate) alive() []bool {
+	var known []*board.Chain
+	for _, c := range []color.Color{color.Black, color.White} {
+		known = append(known, life.UnconditionallyAlive(e.b, c)...)
+	}
+	for _, s := range life.FindSeki(e.b) {
+		known = append(known, s.Chains...)
+	}
+
+	eyes := make(map[point.Point]bool)
+	for _, c := range []color.Color{color.Black, color.White} {
+		for _, eye := range life.Eyes(e.b, c) {
+			if eye.Kind == life.RealEye {
+				eyes[*eye.Point] = true
+			}
+		}
+	}
+
+	out := make([]bool, len(e.chains))
+	for i, ch := range e.chains {
+		var numEyes int
+		for _, lib := range ch.Liberties {
+			if eyes[*lib] {
+				numEyes++
+			}
+		}
+		if numEyes >= 2 {
+			out[i] = true
+			continue
+		}
+		for _, k := range known {
+			if k.Contains(ch.Stones[0]) {
+				out[i] = true
+				break
+			}
+		}
+	}
+	return out
+}
+
+// influence returns the influence of the chains that aren't dead, indexed by
+// y, then x. Black influence is positive, and White influence is negative.
+func (e *estimate) influence(dead []bool) [][]float64 {
+	infl := make([][]float64, e.b.Height())
+	for y := range infl {
+		infl[y] = make([]float64, e.b.Width())
+	}
+	r := e.h.Radius
+	for i, ch := range e.chains {
+		if dead[i] {
+			continue
+		}
+		s := sign(ch.Color)
+		for _, st := range ch.Stones {
+			for x := st.X() - r; x <= st.X()+r; x++ {
+				for y := st.Y() - r; y <= st.Y()+r; y++ {
+					if x < 0 || y < 0 || x >= e.b.Width() || y >= e.b.Height() {
+						continue
+					}
+					infl[y][x] += s * e.h.weight(st, point.New(x, y))
+				}
+			}
+		}
+	}
+	return infl
+}
+
+// score returns the average influence over the empty points that the chain
+// can reach without crossing a living opponent stone, from the perspective of
+// the chain's color. The points of dead stones count as empty. A negative
+// score means the opponent dominates the space the chain needs to live.
+func (e *estimate) score(ch *board.Chain, infl [][]float64) float64 {
+	a := e.living.AreaAt(ch.Stones[0], ch.Color.Opposite())
+	if len(a.Empty) == 0 {
+		return 0
+	}
+	var total float64
+	for _, pt := range a.Empty {
+		total += infl[pt.Y()][pt.X()]
+	}
+	return sign(ch.Color) * total / float64(len(a.Empty))
+}
+
+// weight returns the influence that a stone at from projects onto to.
+func (h *Heuristic) weight(from, to *point.Point) float64 {
+	d := abs(from.X()-to.X()) + abs(from.Y()-to.Y())
+	if d > h.Radius {
+		return 0
+	}
+	return float64(h.Radius+1-d) / float64(h.Radius+1)
+}
+
+// sign returns 1 for Black and -1 for White, which is the sign of each
+// player's influence.
+func sign(c color.Color) float64 {
+	if c == color.White {
+		return -1
+	}
+	return 1
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,115 @@
+package deadstone
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board/boardtest"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestHeuristic_DeadStones(t *testing.T) {
+	testCases := []struct {
+		desc string
+		rows []string
+		exp  []*point.Point
+	}{
+		{
+			desc: "empty board",
+			rows: []string{
+				".....",
+				".....",
+				".....",
+			},
+		},
+		{
+			desc: "stone inside a wall",
+			rows: []string{
+				"..........",
+				".BBBBBB...",
+				".B....B...",
+				".B..W.B...",
+				".B....B...",
+				".BBBBBB...",
+				"..........",
+			},
+			exp: []*point.Point{point.New(4, 3)},
+		},
+		{
+			desc: "chain in the corner",
+			rows: []string{
+				"....W...",
+				".BB.W...",
+				"....W...",
+				"WWWWW...",
+				"........",
+			},
+			exp: []*point.Point{point.New(1, 1), point.New(2, 1)},
+		},
+		{
+			desc: "two dead chains",
+			rows: []string{
+				".....W..",
+				".B...W..",
+				".....W..",
+				"..B..W..",
+				"WWWWWW..",
+				"........",
+			},
+			exp: []*point.Point{point.New(1, 1), point.New(2, 3)},
+		},
+		{
+			desc: "two eyes inside territory",
+			rows: []string{
+				".B.B.W...",
+				"BBBBBW...",
+				"WWWWWW...",
+				".........",
+			},
+		},
+		{
+			desc: "seki",
+			rows: []string{
+				".B.W.W",
+				"BBBWWW",
+			},
+		},
+		{
+			desc: "facing walls",
+			rows: []string{
+				"..BW..",
+				"..BW..",
+				"..BW..",
+				"..BW..",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := NewHeuristic().DeadStones(boardtest.FromDiagram(t, tc.rows...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.exp) {
+				t.Errorf("got dead stones %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+}
+
+func TestStones(t *testing.T) {
+	b := boardtest.FromDiagram(t,
+		"B.W",
+		"B.W",
+		"...")
+	got := Stones(b.Chains())
+	exp := []*point.Point{
+		point.New(0, 0),
+		point.New(0, 1),
+		point.New(2, 0),
+		point.New(2, 1),
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("got %v, expected %v", got, exp)
+	}
+}
@@ -0,0 +1,181 @@
+// Package handicap sets up handicap games: the fixed handicap placements on
+// the star points, and free placement, where Black chooses where to put the
+// handicap stones.
+package handicap
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
+)
+
+var (
+	// ErrInvalidHandicap indicates that a handicap can't be given on a board.
+	ErrInvalidHandicap = errors.New("invalid handicap")
+
+	// ErrInvalidPlacement indicates that free placement stones aren't valid.
+	ErrInvalidPlacement = errors.New("invalid handicap placement")
+)
+
+// MinHandicap is the smallest handicap that places stones. A handicap of one
+// only means that Black plays first without komi.
+const MinHandicap = 2
+
+// MaxFixed returns the largest fixed handicap for a board. Boards smaller than
+// 7x7 have no fixed handicap. Boards with odd dimensions allow up to nine
+// stones, using the star points on the sides and in the center; other boards
+// only allow the four corner star points.
+func MaxFixed(width, height int) int {
+	if width < 7 || height < 7 {
+		return 0
+	}
+	if width%2 == 1 && height%2 == 1 {
+		return 9
+	}
+	return 4
+}
+
+// Fixed returns the points of the fixed handicap stones for a board, in the
+// traditional order: the corners (top-right, bottom-left, bottom-right, and
+// top-left), then the center and sides.
+//
+// The corner stones are on the third line for boards smaller than 13x13, and
+// the fourth line otherwise.
+func Fixed(width, height, n int) ([]*point.Point, error) {
+	maxN := MaxFixed(width, height)
+	if maxN == 0 {
+		return nil, fmt.Errorf("%w: no fixed handicap on a %dx%d board", ErrInvalidHandicap, width, height)
+	}
+	if n < MinHandicap || n > maxN {
+		return nil, fmt.Errorf("%w: handicap %d on a %dx%d board must be between %d and %d", ErrInvalidHandicap, n, width, height, MinHandicap, maxN)
+	}
+
+	edge := 3
+	if width < 13 || height < 13 {
+		edge = 2
+	}
+	left, top := edge, edge
+	right, bottom := width-1-edge, height-1-edge
+	midX, midY := width/2, height/2
+
+	topRight := point.New(right, top)
+	bottomLeft := point.New(left, bottom)
+	bottomRight := point.New(right, bottom)
+	topLeft := point.New(left, top)
+	center := point.New(midX, midY)
+	leftSide := point.New(left, midY)
+	rightSide := point.New(right, midY)
+	topSide := point.New(midX, top)
+	bottomSide := point.New(midX, bottom)
+
+	out := []*point.Point{topRight, bottomLeft, bottomRight, topLeft}
+	switch n {
+	case 2, 3, 4:
+		return out[:n], nil
+	case 5:
+		return append(out, center), nil
+	case 6:
+		return append(out, leftSide, rightSide), nil
+	case 7:
+		return append(out, leftSide, rightSide, center), nil
+	case 8:
+		return append(out, leftSide, rightSide, topSide, bottomSide), nil
+	}
+	return append(out, leftSide, rightSide, topSide, bottomSide, center), nil
+}
+
+// ValidateFree checks the points of freely placed handicap stones: there must
+// be at least two, each must be on the board, and none may be repeated.
+func ValidateFree(width, height int, pts []*point.Point) error {
+	if len(pts) < MinHandicap {
+		return fmt.Errorf("%w: got %d stones, but need at least %d", ErrInvalidPlacement, len(pts), MinHandicap)
+	}
+	if len(pts) >= width*height {
+		return fmt.Errorf("%w: got %d stones, which fill a %dx%d board", ErrInvalidPlacement, len(pts), width, height)
+	}
+	seen := make(map[point.Point]bool)
+	for _, pt := range pts {
+		if pt == nil {
+			return fmt.Errorf("%w: nil point", ErrInvalidPlacement)
+		}
+		if pt.X() < 0 || pt.Y() < 0 || pt.X() >= width || pt.Y() >= height {
+			return fmt.Errorf("%w: %v is off the %dx%d board", ErrInvalidPlacement, pt, width, height)
+		}
+		if seen[*pt] {
+			return fmt.Errorf("%w: %v is repeated", ErrInvalidPlacement, pt)
+		}
+		seen[*pt] = true
+	}
+	return nil
+}
+
+// Setup adds a fixed handicap of n stones to a movetree, using the board
+// size from its game info. See SetupFree for how the movetree is changed.
+func Setup(mt *movetree.MoveTree, n int) error {
+	width, height := dimensions(mt)
+	pts, err := Fixed(width, height, n)
+	if err != nil {
+		return err
+	}
+	return SetupFree(mt, pts)
+}
+
+// SetupFree adds freely placed handicap stones to a movetree. The stones
+// replace any existing black placements on the root, the handicap (HA) is
+// set to the number of stones, and White is set to play first (PL). It's an
+// error for a stone to be on a white placement.
+func SetupFree(mt *movetree.MoveTree, pts []*point.Point) error {
+	width, height := dimensions(mt)
+	if err := ValidateFree(width, height, pts); err != nil {
+		return err
+	}
+
+	root := mt.Root
+	if root.GameInfo == nil {
+		root.GameInfo = &movetree.GameInfo{}
+	}
+	isHandicap := make(map[point.Point]bool)
+	for _, pt := range pts {
+		isHandicap[*pt] = true
+	}
+	var placements move.List
+	for _, mv := range root.Placements {
+		if mv.Color() == color.Black {
+			continue
+		}
+		if mv.Point() != nil && isHandicap[*mv.Point()] {
+			return fmt.Errorf("%w: %v already has a %v stone", ErrInvalidPlacement, mv.Point(), mv.Color())
+		}
+		placements = append(placements, mv)
+	}
+	for _, pt := range pts {
+		placements = append(placements, move.New(color.Black, pt))
+	}
+	root.Placements = placements
+	root.GameInfo.Handicap = len(pts)
+	root.GameInfo.Player = color.White
+	return nil
+}
+
+// NewGame creates a movetree for a square board of the given size, with a
+// fixed handicap of n stones.
+func NewGame(size, n int) (*movetree.MoveTree, error) {
+	mt := movetree.New()
+	mt.Root.GameInfo.Size = size
+	if err := Setup(mt, n); err != nil {
+		return nil, err
+	}
+	return mt, nil
+}
+
+// dimensions returns the board dimensions of a movetree.
+func dimensions(mt *movetree.MoveTree) (int, int) {
+	if mt.Root.GameInfo == nil {
+		return (&movetree.GameInfo{}).Dimensions()
+	}
+	return mt.Root.GameInfo.Dimensions()
+}
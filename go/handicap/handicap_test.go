@@ -0,0 +1,201 @@
+package handicap
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestFixed(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		width, height int
+		n             int
+		exp           []*point.Point
+		expErr        error
+	}{
+		{
+			desc:  "19x19, two stones",
+			width: 19, height: 19,
+			n:   2,
+			exp: []*point.Point{point.New(15, 3), point.New(3, 15)},
+		},
+		{
+			desc:  "19x19, five stones",
+			width: 19, height: 19,
+			n: 5,
+			exp: []*point.Point{
+				point.New(15, 3), point.New(3, 15), point.New(15, 15), point.New(3, 3),
+				point.New(9, 9),
+			},
+		},
+		{
+			desc:  "19x19, six stones",
+			width: 19, height: 19,
+			n: 6,
+			exp: []*point.Point{
+				point.New(15, 3), point.New(3, 15), point.New(15, 15), point.New(3, 3),
+				point.New(3, 9), point.New(15, 9),
+			},
+		},
+		{
+			desc:  "19x19, nine stones",
+			width: 19, height: 19,
+			n: 9,
+			exp: []*point.Point{
+				point.New(15, 3), point.New(3, 15), point.New(15, 15), point.New(3, 3),
+				point.New(3, 9), point.New(15, 9), point.New(9, 3), point.New(9, 15),
+				point.New(9, 9),
+			},
+		},
+		{
+			desc:  "9x9, three stones",
+			width: 9, height: 9,
+			n:   3,
+			exp: []*point.Point{point.New(6, 2), point.New(2, 6), point.New(6, 6)},
+		},
+		{
+			desc:  "13x13, four stones",
+			width: 13, height: 13,
+			n: 4,
+			exp: []*point.Point{
+				point.New(9, 3), point.New(3, 9), point.New(9, 9), point.New(3, 3),
+			},
+		},
+		{
+			desc:  "too many stones",
+			width: 19, height: 19,
+			n:      10,
+			expErr: ErrInvalidHandicap,
+		},
+		{
+			desc:  "too few stones",
+			width: 19, height: 19,
+			n:      1,
+			expErr: ErrInvalidHandicap,
+		},
+		{
+			desc:  "even board has no center",
+			width: 10, height: 10,
+			n:      5,
+			expErr: ErrInvalidHandicap,
+		},
+		{
+			desc:  "small board",
+			width: 5, height: 5,
+			n:      2,
+			expErr: ErrInvalidHandicap,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := Fixed(tc.width, tc.height, tc.n)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, expected %v", err, tc.expErr)
+			}
+			if !reflect.DeepEqual(got, tc.exp) {
+				t.Errorf("got %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+}
+
+func TestValidateFree(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		pts    []*point.Point
+		expErr error
+	}{
+		{
+			desc: "valid",
+			pts:  []*point.Point{point.New(0, 0), point.New(4, 2)},
+		},
+		{
+			desc:   "one stone",
+			pts:    []*point.Point{point.New(0, 0)},
+			expErr: ErrInvalidPlacement,
+		},
+		{
+			desc:   "off the board",
+			pts:    []*point.Point{point.New(0, 0), point.New(5, 0)},
+			expErr: ErrInvalidPlacement,
+		},
+		{
+			desc:   "repeated",
+			pts:    []*point.Point{point.New(1, 1), point.New(1, 1)},
+			expErr: ErrInvalidPlacement,
+		},
+		{
+			desc:   "nil point",
+			pts:    []*point.Point{point.New(1, 1), nil},
+			expErr: ErrInvalidPlacement,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := ValidateFree(5, 3, tc.pts); !errors.Is(err, tc.expErr) {
+				t.Errorf("got error %v, expected %v", err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestNewGame(t *testing.T) {
+	mt, err := NewGame(9, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := move.List{
+		move.New(color.Black, point.New(6, 2)),
+		move.New(color.Black, point.New(2, 6)),
+	}
+	if !reflect.DeepEqual(mt.Root.Placements, exp) {
+		t.Errorf("got placements %v, expected %v", mt.Root.Placements, exp)
+	}
+	gi := mt.Root.GameInfo
+	if gi.Handicap != 2 || gi.Player != color.White || gi.Size != 9 {
+		t.Errorf("got game info %+v, expected a 9x9 board with handicap 2 and White to play", gi)
+	}
+
+	if _, err := NewGame(9, 9); err != nil {
+		t.Errorf("got error %v for nine stones on 9x9, expected none", err)
+	}
+	if _, err := NewGame(19, 12); !errors.Is(err, ErrInvalidHandicap) {
+		t.Errorf("got error %v, expected %v", err, ErrInvalidHandicap)
+	}
+}
+
+func TestSetupFree(t *testing.T) {
+	mt := movetree.New()
+	mt.Root.Placements = move.List{
+		move.New(color.Black, point.New(0, 0)),
+		move.New(color.White, point.New(1, 1)),
+	}
+	pts := []*point.Point{point.New(3, 3), point.New(16, 2), point.New(9, 9)}
+	if err := SetupFree(mt, pts); err != nil {
+		t.Fatal(err)
+	}
+	exp := move.List{
+		move.New(color.White, point.New(1, 1)),
+		move.New(color.Black, point.New(3, 3)),
+		move.New(color.Black, point.New(16, 2)),
+		move.New(color.Black, point.New(9, 9)),
+	}
+	if !reflect.DeepEqual(mt.Root.Placements, exp) {
+		t.Errorf("got placements %v, expected %v", mt.Root.Placements, exp)
+	}
+	if mt.Root.GameInfo.Handicap != 3 {
+		t.Errorf("got handicap %d, expected 3", mt.Root.GameInfo.Handicap)
+	}
+
+	// A handicap stone can't go on a white stone.
+	err := SetupFree(mt, []*point.Point{point.New(1, 1), point.New(2, 2)})
+	if !errors.Is(err, ErrInvalidPlacement) {
+		t.Errorf("got error %v, expected %v", err, ErrInvalidPlacement)
+	}
+}
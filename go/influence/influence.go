@@ -0,0 +1,218 @@
+// Package influence estimates the influence of the stones on a board: which
+// player controls (or is likely to control) each intersection.
+//
+// The estimates use Bouzy's dilation and erosion operators, as described in
+// Bruno Bouzy, "Mathematical morphology applied to computer go" (2003).
+package influence
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// stoneValue is the initial value of a stone for the dilations and erosions.
+const stoneValue = 128
+
+// Map contains a value in [-1,1] for each intersection, indexed by y, then x.
+// Positive values favor Black, and negative values favor White.
+type Map [][]float64
+
+// New creates a Map where every intersection is zero.
+func New(width, height int) Map {
+	m := make(Map, height)
+	for y := range m {
+		m[y] = make([]float64, width)
+	}
+	return m
+}
+
+// Dimensions returns the width and height of the map.
+func (m Map) Dimensions() (int, int) {
+	if len(m) == 0 {
+		return 0, 0
+	}
+	return len(m[0]), len(m)
+}
+
+// At returns the value at point pt, or 0 if pt is outside the map.
+func (m Map) At(pt *point.Point) float64 {
+	w, h := m.Dimensions()
+	if pt.X() < 0 || pt.Y() < 0 || pt.X() >= w || pt.Y() >= h {
+		return 0
+	}
+	return m[pt.Y()][pt.X()]
+}
+
+// Owner returns the color that the map favors at point pt, if the value's
+// magnitude is greater than threshold. Otherwise, it returns color.Empty.
+func (m Map) Owner(pt *point.Point, threshold float64) color.Color {
+	v := m.At(pt)
+	switch {
+	case v > threshold:
+		return color.Black
+	case v < -threshold:
+		return color.White
+	}
+	return color.Empty
+}
+
+// Territory estimates the territory of each player, using Bouzy's 5/21
+// operator: 5 dilations followed by 21 erosions. Only intersections that are
+// surrounded by one player keep a value.
+func Territory(b *board.Board) Map {
+	return Bouzy(b, 5, 21)
+}
+
+// Moyo estimates the frameworks (moyos) of each player, using 5 dilations
+// followed by 10 erosions.
+func Moyo(b *board.Board) Map {
+	return Bouzy(b, 5, 10)
+}
+
+// Area estimates the area of influence of each player, using 4 dilations and
+// no erosions.
+func Area(b *board.Board) Map {
+	return Bouzy(b, 4, 0)
+}
+
+// Bouzy estimates influence with Bouzy's dilation and erosion operators.
+//
+// Each stone starts with the value 128 (or -128 for White). A dilation
+// spreads influence into points that aren't adjacent to the other player's
+// influence, and an erosion shrinks influence from its edges. Stones are
+// always 1 or -1 in the result; the other intersections are scaled by the
+// largest value that the dilations can produce, so that a point deep inside
+// a player's area approaches 1 or -1.
+func Bouzy(b *board.Board, dilations, erosions int) Map {
+	e := newEngine(b)
+	for i := 0; i < dilations; i++ {
+		e.dilate()
+	}
+	for i := 0; i < erosions; i++ {
+		e.erode()
+	}
+
+	scale := float64(4 * dilations)
+	if scale == 0 {
+		scale = 1
+	}
+	out := New(e.width, e.height)
+	for i, v := range e.values {
+		x, y := i%e.width, i/e.width
+		if e.stones[i] != color.Empty {
+			out[y][x] = sign(e.stones[i])
+			continue
+		}
+		out[y][x] = clamp(float64(v) / scale)
+	}
+	return out
+}
+
+// engine applies the dilations and erosions to the values of the
+// intersections, indexed by y*width+x.
+type engine struct {
+	width, height int
+	stones        []color.Color
+	values        []int
+	next          []int
+	nb            [][]int
+}
+
+func newEngine(b *board.Board) *engine {
+	w, h := b.Width(), b.Height()
+	e := &engine{
+		width:  w,
+		height: h,
+		stones: make([]color.Color, w*h),
+		values: make([]int, w*h),
+		next:   make([]int, w*h),
+		nb:     make([][]int, w*h),
+	}
+	for y, row := range b.FullBoardState() {
+		for x, c := range row {
+			i := y*w + x
+			e.stones[i] = c
+			switch c {
+			case color.Black:
+				e.values[i] = stoneValue
+			case color.White:
+				e.values[i] = -stoneValue
+			}
+			for _, n := range b.Neighbors(point.New(x, y)) {
+				e.nb[i] = append(e.nb[i], n.Y()*w+n.X())
+			}
+		}
+	}
+	return e
+}
+
+// dilate adds, to each point that isn't next to the other player's
+// influence, the number of neighbors with the same sign.
+func (e *engine) dilate() {
+	for i, v := range e.values {
+		var pos, neg int
+		for _, n := range e.nb[i] {
+			switch nv := e.values[n]; {
+			case nv > 0:
+				pos++
+			case nv < 0:
+				neg++
+			}
+		}
+		switch {
+		case v >= 0 && neg == 0:
+			v += pos
+		case v <= 0 && pos == 0:
+			v -= neg
+		}
+		e.next[i] = v
+	}
+	e.values, e.next = e.next, e.values
+}
+
+// erode subtracts, from each point with influence, the number of neighbors
+// that don't share its sign, without changing the sign of the point.
+func (e *engine) erode() {
+	for i, v := range e.values {
+		var count int
+		for _, n := range e.nb[i] {
+			nv := e.values[n]
+			if (v > 0 && nv <= 0) || (v < 0 && nv >= 0) {
+				count++
+			}
+		}
+		switch {
+		case v > 0:
+			v -= count
+			if v < 0 {
+				v = 0
+			}
+		case v < 0:
+			v += count
+			if v > 0 {
+				v = 0
+			}
+		}
+		e.next[i] = v
+	}
+	e.values, e.next = e.next, e.values
+}
+
+// sign returns 1 for Black and -1 for White.
+func sign(c color.Color) float64 {
+	if c == color.White {
+		return -1
+	}
+	return 1
+}
+
+func clamp(v float64) float64 {
+	switch {
+	case v > 1:
+		return 1
+	case v < -1:
+		return -1
+	}
+	return v
+}
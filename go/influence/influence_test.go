@@ -0,0 +1,160 @@
+package influence
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/board/boardtest"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// owners converts a map to rows of B, W, and . characters, using the Owner
+// of each point.
+func owners(m Map, threshold float64) []string {
+	var out []string
+	for y, row := range m {
+		var s []byte
+		for x := range row {
+			switch m.Owner(point.New(x, y), threshold) {
+			case color.Black:
+				s = append(s, 'B')
+			case color.White:
+				s = append(s, 'W')
+			default:
+				s = append(s, '.')
+			}
+		}
+		out = append(out, string(s))
+	}
+	return out
+}
+
+func TestBouzy(t *testing.T) {
+	testCases := []struct {
+		desc string
+		rows []string
+		fn   func(*board.Board) Map
+		exp  []string
+	}{
+		{
+			desc: "empty board",
+			rows: []string{
+				".....",
+				".....",
+				".....",
+			},
+			fn: Territory,
+			exp: []string{
+				".....",
+				".....",
+				".....",
+			},
+		},
+		{
+			desc: "enclosed corner",
+			rows: []string{
+				"..B....",
+				"..B....",
+				"BBB....",
+				".......",
+				"...W...",
+				".......",
+			},
+			fn: Territory,
+			exp: []string{
+				"BBB....",
+				"BBB....",
+				"BBB....",
+				".......",
+				"...W...",
+				".......",
+			},
+		},
+		{
+			desc: "facing walls",
+			rows: []string{
+				".B..W.",
+				".B..W.",
+				".B..W.",
+				".B..W.",
+			},
+			fn: Moyo,
+			exp: []string{
+				"BB..WW",
+				"BB..WW",
+				"BB..WW",
+				"BB..WW",
+			},
+		},
+		{
+			desc: "area of single stones",
+			rows: []string{
+				".......",
+				".B...W.",
+				".......",
+			},
+			fn: Area,
+			exp: []string{
+				"BBB.WWW",
+				"BBB.WWW",
+				"BBB.WWW",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			m := tc.fn(boardtest.FromDiagram(t, tc.rows...))
+			got := owners(m, 0)
+			for y := range tc.exp {
+				if got[y] != tc.exp[y] {
+					t.Errorf("got row %d %q, expected %q", y, got[y], tc.exp[y])
+				}
+			}
+		})
+	}
+}
+
+func TestBouzy_Values(t *testing.T) {
+	b := boardtest.FromDiagram(t,
+		"..B....",
+		"..B....",
+		"BBB....",
+		"......W")
+	m := Territory(b)
+	if w, h := m.Dimensions(); w != 7 || h != 4 {
+		t.Errorf("got dimensions %dx%d, expected 7x4", w, h)
+	}
+	for y, row := range m {
+		for x, v := range row {
+			if v < -1 || v > 1 {
+				t.Errorf("got value %v at {%d,%d}, expected a value in [-1,1]", v, x, y)
+			}
+		}
+	}
+	if v := m.At(point.New(2, 0)); v != 1 {
+		t.Errorf("got %v for a black stone, expected 1", v)
+	}
+	if v := m.At(point.New(6, 3)); v != -1 {
+		t.Errorf("got %v for a white stone, expected -1", v)
+	}
+	if v := m.At(point.New(0, 0)); v <= 0 {
+		t.Errorf("got %v inside black's corner, expected a positive value", v)
+	}
+	if v := m.At(point.New(7, 0)); v != 0 {
+		t.Errorf("got %v off the board, expected 0", v)
+	}
+}
+
+func TestMap_Owner(t *testing.T) {
+	m := New(3, 1)
+	m[0][0] = 0.5
+	m[0][1] = 0.1
+	m[0][2] = -0.5
+	exp := []color.Color{color.Black, color.Empty, color.White}
+	for x, c := range exp {
+		if got := m.Owner(point.New(x, 0), 0.25); got != c {
+			t.Errorf("got owner %v at x=%d, expected %v", got, x, c)
+		}
+	}
+}
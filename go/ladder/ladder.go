@@ -0,0 +1,206 @@
+// Package ladder reads ladders (shicho): sequences where the attacker keeps
+// a chain in atari at every move until it's captured or escapes.
+package ladder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+var (
+	// ErrNoChain indicates that there's no stone at the given point.
+	ErrNoChain = errors.New("no chain at point")
+
+	// ErrNotLadder indicates that the chain doesn't have one or two
+	// liberties, and so can't be laddered.
+	ErrNotLadder = errors.New("chain can't be laddered")
+)
+
+// maxDepth is the maximum number of moves read in a ladder, which bounds the
+// reading for pathological positions.
+const maxDepth = 200
+
+// Result contains the outcome of reading a ladder.
+type Result struct {
+	// Captured indicates whether the chain is captured by the ladder.
+	Captured bool
+
+	// Moves contains the main line of the ladder. If the chain is captured, it
+	// ends with the capturing move. If the chain escapes, it ends with the move
+	// that gives the chain three or more liberties (or that the attacker can't
+	// follow up).
+	Moves move.List
+}
+
+// Read reads the ladder for the chain containing the stone at pt. The board
+// isn't modified.
+//
+// If the chain is in atari, the chain's owner moves first and tries to escape
+// by extending or by capturing an adjacent attacking chain. If the chain has
+// two liberties, the attacker moves first and tries each liberty to put the
+// chain in atari. Either way, the attacker only plays moves that keep the
+// chain in atari.
+func Read(b *board.Board, pt *point.Point) (*Result, error) {
+	chain := b.ChainAt(pt)
+	if chain == nil {
+		return nil, fmt.Errorf("%w: %v", ErrNoChain, pt)
+	}
+
+	r := &reader{b: b.Clone()}
+	r.b.EnableHistory()
+	switch chain.LibertyCount() {
+	case 1:
+		captured, moves := r.defend(pt, 0)
+		return &Result{Captured: captured, Moves: moves}, nil
+	case 2:
+		captured, moves := r.attack(pt, 0)
+		return &Result{Captured: captured, Moves: moves}, nil
+	}
+	return nil, fmt.Errorf("%w: chain at %v has %d liberties", ErrNotLadder, pt, chain.LibertyCount())
+}
+
+// Breaks indicates whether playing move m breaks the ladder for the chain
+// containing the stone at pt: the chain is captured by the ladder before the
+// move, but escapes after it. The move m is typically a ladder breaker played
+// away from the chain, so the chain's liberties are counted after the move.
+func Breaks(b *board.Board, pt *point.Point, m *move.Move) (bool, error) {
+	before, err := Read(b, pt)
+	if err != nil {
+		return false, err
+	}
+	if !before.Captured {
+		return false, nil
+	}
+	after := b.Clone()
+	if _, err := after.PlaceStone(m); err != nil {
+		return false, err
+	}
+	if after.ChainAt(pt) == nil {
+		// The move captured the chain.
+		return false, nil
+	}
+	res, err := Read(after, pt)
+	if errors.Is(err, ErrNotLadder) {
+		// The move gave the chain enough liberties to escape.
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return !res.Captured, nil
+}
+
+// reader reads a ladder by playing and undoing moves on its board.
+type reader struct {
+	b *board.Board
+}
+
+// defend tries to save the chain at pt, which is in atari, with the chain's
+// owner to move. It returns whether the chain is captured and the main line.
+func (r *reader) defend(pt *point.Point, depth int) (bool, move.List) {
+	chain := r.b.ChainAt(pt)
+	if depth >= maxDepth {
+		return false, nil
+	}
+
+	// The candidates are extending at the liberty or capturing an adjacent
+	// attacking chain that's in atari.
+	var candidates move.List
+	candidates = append(candidates, move.New(chain.Color, chain.Liberties[0]))
+	for _, adj := range r.b.AdjacentChains(pt) {
+		if adj.InAtari() {
+			candidates = append(candidates, move.New(chain.Color, adj.Liberties[0]))
+		}
+	}
+
+	var line move.List
+	for i, m := range candidates {
+		if _, err := r.b.PlaceStone(m); err != nil {
+			continue
+		}
+		var captured bool
+		var rest move.List
+		switch libs := r.b.LibertyCount(pt); {
+		case libs == 0:
+			// The move was suicide, which some rules allow, and removed the
+			// chain.
+			captured = true
+		case libs == 1:
+			captured, rest = r.capture(pt)
+		case libs == 2:
+			captured, rest = r.attack(pt, depth+1)
+		default:
+			captured = false
+		}
+		r.undo()
+
+		seq := append(move.List{m}, rest...)
+		if !captured {
+			return false, seq
+		}
+		if i == 0 {
+			line = seq
+		}
+	}
+	if line == nil {
+		// No escape move can be played; the attacker captures at the liberty.
+		return r.capture(pt)
+	}
+	return true, line
+}
+
+// attack tries to capture the chain at pt, which has two liberties, with the
+// attacker to move. It returns whether the chain is captured and the main
+// line.
+func (r *reader) attack(pt *point.Point, depth int) (bool, move.List) {
+	chain := r.b.ChainAt(pt)
+	if depth >= maxDepth {
+		return false, nil
+	}
+	attacker := chain.Color.Opposite()
+
+	var line move.List
+	for _, lib := range chain.Liberties {
+		m := move.New(attacker, lib)
+		if _, err := r.b.PlaceStone(m); err != nil {
+			continue
+		}
+		var captured bool
+		var rest move.List
+		if r.b.LibertyCount(pt) == 1 {
+			captured, rest = r.defend(pt, depth+1)
+		}
+		r.undo()
+
+		seq := append(move.List{m}, rest...)
+		if captured {
+			return true, seq
+		}
+		if line == nil {
+			line = seq
+		}
+	}
+	return false, line
+}
+
+// capture plays the capturing move on the last liberty of the chain at pt,
+// which is in atari, with the attacker to move.
+func (r *reader) capture(pt *point.Point) (bool, move.List) {
+	chain := r.b.ChainAt(pt)
+	m := move.New(chain.Color.Opposite(), chain.Liberties[0])
+	if ok, _ := r.b.IsLegal(m); !ok {
+		return false, nil
+	}
+	return true, move.List{m}
+}
+
+// undo takes back the last move played by the reader.
+func (r *reader) undo() {
+	if _, err := r.b.Undo(); err != nil {
+		// The reader only undoes moves it placed, so this can't happen.
+		panic(err)
+	}
+}
@@ -0,0 +1,222 @@
+package ladder
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// newBoard creates a 19x19 board with the given stones.
+func newBoard(t *testing.T, ml move.List) *board.Board {
+	t.Helper()
+	b := board.New(19)
+	if err := b.SetPlacements(ml); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// inAtari contains a black stone at {9,9} in atari, which runs toward the
+// top-right corner.
+var inAtari = move.List{
+	move.New(color.Black, point.New(9, 9)),
+	move.New(color.White, point.New(9, 10)),
+	move.New(color.White, point.New(8, 9)),
+	move.New(color.White, point.New(10, 10)),
+	move.New(color.White, point.New(9, 8)),
+}
+
+func TestRead(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		stones      move.List
+		pt          *point.Point
+		expCaptured bool
+		expFirst    move.List
+		expLast     *move.Move
+		expLen      int
+		expErr      error
+	}{
+		{
+			desc:        "ladder works",
+			stones:      inAtari,
+			pt:          point.New(9, 9),
+			expCaptured: true,
+			expFirst: move.List{
+				move.New(color.Black, point.New(10, 9)),
+				move.New(color.White, point.New(11, 9)),
+				move.New(color.Black, point.New(10, 8)),
+				move.New(color.White, point.New(10, 7)),
+			},
+			expLast: move.New(color.White, point.New(18, 4)),
+			expLen:  34,
+		},
+		{
+			desc:        "ladder breaker",
+			stones:      append(move.List{move.New(color.Black, point.New(14, 4))}, inAtari...),
+			pt:          point.New(9, 9),
+			expCaptured: false,
+			expFirst: move.List{
+				move.New(color.Black, point.New(10, 9)),
+				move.New(color.White, point.New(10, 8)),
+				move.New(color.Black, point.New(11, 9)),
+			},
+			expLast: move.New(color.Black, point.New(11, 9)),
+			expLen:  3,
+		},
+		{
+			desc: "two liberties, attacker moves first",
+			stones: move.List{
+				move.New(color.Black, point.New(3, 3)),
+				move.New(color.White, point.New(3, 2)),
+				move.New(color.White, point.New(2, 3)),
+				move.New(color.White, point.New(4, 4)),
+			},
+			pt:          point.New(3, 3),
+			expCaptured: true,
+			expFirst: move.List{
+				move.New(color.White, point.New(3, 4)),
+				move.New(color.Black, point.New(4, 3)),
+			},
+			expLast: move.New(color.White, point.New(7, 0)),
+			expLen:  13,
+		},
+		{
+			desc: "escape by capturing",
+			stones: move.List{
+				move.New(color.Black, point.New(2, 2)),
+				move.New(color.White, point.New(1, 2)),
+				move.New(color.White, point.New(2, 3)),
+				move.New(color.White, point.New(3, 2)),
+				move.New(color.White, point.New(2, 0)),
+				move.New(color.White, point.New(3, 1)),
+				move.New(color.Black, point.New(0, 2)),
+				move.New(color.Black, point.New(1, 3)),
+			},
+			pt:          point.New(2, 2),
+			expCaptured: false,
+			expFirst: move.List{
+				move.New(color.Black, point.New(1, 1)),
+				move.New(color.White, point.New(2, 1)),
+			},
+			expLast: move.New(color.Black, point.New(1, 2)),
+			expLen:  3,
+		},
+		{
+			desc:   "empty point",
+			stones: inAtari,
+			pt:     point.New(0, 0),
+			expErr: ErrNoChain,
+		},
+		{
+			desc:   "too many liberties",
+			stones: move.List{move.New(color.Black, point.New(3, 3))},
+			pt:     point.New(3, 3),
+			expErr: ErrNotLadder,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			b := newBoard(t, tc.stones)
+			before := b.String()
+			res, err := Read(b, tc.pt)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, but expected error %v", err, tc.expErr)
+			}
+			if err != nil {
+				return
+			}
+			if b.String() != before {
+				t.Errorf("the board was modified:\n%v", b)
+			}
+			if res.Captured != tc.expCaptured {
+				t.Errorf("got captured=%v, expected %v; moves=%v", res.Captured, tc.expCaptured, res.Moves)
+			}
+			if len(res.Moves) != tc.expLen {
+				t.Fatalf("got %d moves, expected %d; moves=%v", len(res.Moves), tc.expLen, res.Moves)
+			}
+			if got := res.Moves[:len(tc.expFirst)]; !reflect.DeepEqual(got, tc.expFirst) {
+				t.Errorf("got first moves %v, expected %v", got, tc.expFirst)
+			}
+			if got := res.Moves[len(res.Moves)-1]; !reflect.DeepEqual(got, tc.expLast) {
+				t.Errorf("got last move %v, expected %v", got, tc.expLast)
+			}
+		})
+	}
+}
+
+func TestRead_SequenceCaptures(t *testing.T) {
+	b := newBoard(t, inAtari)
+	res, err := Read(b, point.New(9, 9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range res.Moves {
+		if _, err := b.PlaceStone(m); err != nil {
+			t.Fatalf("playing %v: %v", m, err)
+		}
+	}
+	if c := b.ChainAt(point.New(9, 9)); c != nil {
+		t.Errorf("got chain %v at {9,9} after the ladder, expected it to be captured", c)
+	}
+}
+
+func TestRead_Suicide(t *testing.T) {
+	// The black stone in the corner can only extend into a suicide.
+	b := newBoard(t, move.List{
+		move.New(color.Black, point.New(0, 0)),
+		move.New(color.White, point.New(1, 0)),
+		move.New(color.White, point.New(1, 1)),
+		move.New(color.White, point.New(0, 2)),
+	})
+	for _, rules := range []board.Rules{{}, {Ko: board.PositionalSuperko, AllowSuicide: true}} {
+		b.SetRules(rules)
+		res, err := Read(b, point.New(0, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Captured {
+			t.Errorf("with rules %+v, got an escape by %v, expected the chain to be captured", rules, res.Moves)
+		}
+	}
+}
+
+func TestBreaks(t *testing.T) {
+	testCases := []struct {
+		desc string
+		m    *move.Move
+		exp  bool
+	}{
+		{
+			desc: "on the ladder's path",
+			m:    move.New(color.Black, point.New(14, 4)),
+			exp:  true,
+		},
+		{
+			desc: "away from the ladder's path",
+			m:    move.New(color.Black, point.New(2, 16)),
+			exp:  false,
+		},
+		{
+			desc: "attacker's stone",
+			m:    move.New(color.White, point.New(14, 4)),
+			exp:  false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := Breaks(newBoard(t, inAtari), point.New(9, 9), tc.m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.exp {
+				t.Errorf("got %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package life
+
+import (
+	"sort"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+)
+
+// UnconditionallyAlive returns the chains of color c that can't be captured,
+// even if c passes every turn, ordered by their first stone. This uses
+// Benson's algorithm:
+//
+//  1. Start with all the chains of color c and all the regions enclosed by c.
+//  2. Remove each chain that has fewer than two vital regions, where a region
+//     is vital to a chain if all of its empty points are liberties of the
+//     chain.
+//  3. Remove each region that borders a removed chain.
+//  4. Repeat steps 2 and 3 until nothing changes.
+//
+// The chains that remain are unconditionally alive.
+func UnconditionallyAlive(b *board.Board, c color.Color) []*board.Chain {
+	regions := Regions(b, c)
+
+	// Every chain of color c borders a region, unless it fills the board, in
+	// which case it has no vital regions.
+	var chains []*board.Chain
+	alive := make(map[*board.Chain]bool)
+	healthy := make(map[*Region]bool)
+	for _, r := range regions {
+		healthy[r] = true
+		for _, ch := range r.Chains {
+			if !alive[ch] {
+				alive[ch] = true
+				chains = append(chains, ch)
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for ch := range alive {
+			vital := 0
+			for _, r := range regions {
+				if healthy[r] && borders(r, ch) && r.IsVitalTo(ch) {
+					vital++
+				}
+			}
+			if vital < 2 {
+				delete(alive, ch)
+				changed = true
+			}
+		}
+		for r := range healthy {
+			for _, ch := range r.Chains {
+				if !alive[ch] {
+					delete(healthy, r)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	var out []*board.Chain
+	for _, ch := range chains {
+		if alive[ch] {
+			out = append(out, ch)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Stones[0].Less(out[j].Stones[0])
+	})
+	return out
+}
+
+// borders indicates whether chain ch borders region r.
+func borders(r *Region, ch *board.Chain) bool {
+	for _, rc := range r.Chains {
+		if rc == ch {
+			return true
+		}
+	}
+	return false
+}
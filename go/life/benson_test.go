@@ -0,0 +1,86 @@
+package life
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/board/boardtest"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestUnconditionallyAlive(t *testing.T) {
+	testCases := []struct {
+		desc string
+		rows []string
+		c    color.Color
+		exp  []*point.Point
+	}{
+		{
+			desc: "two eyes",
+			rows: []string{
+				".B.B.",
+				"BBBBB",
+				".....",
+				".....",
+			},
+			c:   color.Black,
+			exp: []*point.Point{point.New(0, 1)},
+		},
+		{
+			desc: "one eye",
+			rows: []string{
+				".B...",
+				"BB...",
+				".....",
+			},
+			c: color.Black,
+		},
+		{
+			desc: "two chains sharing two eyes",
+			rows: []string{
+				".B.W.",
+				"B.BW.",
+				"BBBW.",
+				"WWWW.",
+			},
+			c: color.Black,
+			exp: []*point.Point{
+				point.New(0, 1),
+				point.New(1, 0),
+			},
+		},
+		{
+			desc: "eye containing an opponent stone",
+			rows: []string{
+				".BW.B.",
+				"BBBBBB",
+				"......",
+				"......",
+			},
+			c:   color.Black,
+			exp: []*point.Point{point.New(0, 1)},
+		},
+		{
+			desc: "seki is not unconditionally alive",
+			rows: []string{
+				".B.W.W",
+				"BBBWWW",
+			},
+			c: color.White,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			b := boardtest.FromDiagram(t, tc.rows...)
+			got := UnconditionallyAlive(b, tc.c)
+			if len(got) != len(tc.exp) {
+				t.Fatalf("got %d alive chains, expected %d: %v", len(got), len(tc.exp), got)
+			}
+			for i, ch := range got {
+				if !ch.Stones[0].Equal(tc.exp[i]) {
+					t.Errorf("got alive chain starting at %v, expected %v", ch.Stones[0], tc.exp[i])
+				}
+			}
+		})
+	}
+}
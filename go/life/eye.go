@@ -0,0 +1,110 @@
+package life
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// EyeKind indicates whether an eye-shaped point is a real eye.
+type EyeKind int
+
+const (
+	// NotEye is a point that isn't surrounded by stones of a single color.
+	NotEye EyeKind = iota
+
+	// RealEye is an eye that can't be filled by the opponent.
+	RealEye
+
+	// FalseEye is an eye-shaped point where the opponent can capture (or force
+	// the connection of) one of the surrounding stones, so that it can't be
+	// counted as an eye.
+	FalseEye
+)
+
+// String returns the name of the eye kind.
+func (k EyeKind) String() string {
+	switch k {
+	case RealEye:
+		return "real"
+	case FalseEye:
+		return "false"
+	}
+	return "none"
+}
+
+// Eye is a single empty point surrounded by stones of one color.
+type Eye struct {
+	Point *point.Point
+	Color color.Color
+	Kind  EyeKind
+}
+
+// EyeAt returns the eye at point pt: an empty point whose orthogonal
+// neighbors are all stones of the same color. It returns nil if pt isn't an
+// eye.
+//
+// The eye is real if the surrounding stones are all in one chain. Otherwise,
+// it uses the usual diagonal rule: the eye is false if the opponent occupies
+// two or more of the diagonal points in the center of the board, or one or
+// more on the edge or in the corner.
+func EyeAt(b *board.Board, pt *point.Point) *Eye {
+	return eyeAt(b, b.FullBoardState(), pt)
+}
+
+// Eyes returns the eyes of color c, ordered by point.
+func Eyes(b *board.Board, c color.Color) []*Eye {
+	state := b.FullBoardState()
+	var out []*Eye
+	for _, a := range b.Areas(c) {
+		if isEye(a) {
+			out = append(out, newEye(state, a))
+		}
+	}
+	return out
+}
+
+// eyeAt returns the eye at point pt, or nil if pt isn't an eye. State is the
+// full board state of b.
+func eyeAt(b *board.Board, state [][]color.Color, pt *point.Point) *Eye {
+	for _, c := range []color.Color{color.Black, color.White} {
+		if a := b.AreaAt(pt, c); a != nil && isEye(a) {
+			return newEye(state, a)
+		}
+	}
+	return nil
+}
+
+// isEye indicates whether area a is an eye: a single empty point enclosed by
+// stones.
+func isEye(a *board.Area) bool {
+	return len(a.Points) == 1 && len(a.Empty) == 1 && len(a.Chains) > 0
+}
+
+// diagonalOffsets are the offsets of the diagonal neighbors of a point.
+var diagonalOffsets = [4][2]int{{1, 1}, {-1, 1}, {1, -1}, {-1, -1}}
+
+// newEye classifies the eye formed by area a, using the full board state to
+// look up the diagonal points.
+func newEye(state [][]color.Color, a *board.Area) *Eye {
+	pt := a.Points[0]
+	e := &Eye{Point: pt, Color: a.Owner, Kind: RealEye}
+	if len(a.Chains) == 1 {
+		return e
+	}
+	var diags, opp int
+	for _, d := range diagonalOffsets {
+		x, y := pt.X()+d[0], pt.Y()+d[1]
+		if y < 0 || y >= len(state) || x < 0 || x >= len(state[y]) {
+			continue
+		}
+		diags++
+		if state[y][x] == a.Owner.Opposite() {
+			opp++
+		}
+	}
+	if (diags == 4 && opp >= 2) || (diags < 4 && opp >= 1) {
+		e.Kind = FalseEye
+	}
+	return e
+}
@@ -0,0 +1,108 @@
+package life
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board/boardtest"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestEyeAt(t *testing.T) {
+	testCases := []struct {
+		desc string
+		rows []string
+		pt   *point.Point
+		exp  *Eye
+	}{
+		{
+			desc: "single chain",
+			rows: []string{
+				".B.",
+				"BB.",
+				"...",
+			},
+			pt:  point.New(0, 0),
+			exp: &Eye{Point: point.New(0, 0), Color: color.Black, Kind: RealEye},
+		},
+		{
+			desc: "center, one opposing diagonal",
+			rows: []string{
+				".....",
+				".WB..",
+				".B.B.",
+				"..B..",
+				".....",
+			},
+			pt:  point.New(2, 2),
+			exp: &Eye{Point: point.New(2, 2), Color: color.Black, Kind: RealEye},
+		},
+		{
+			desc: "center, two opposing diagonals",
+			rows: []string{
+				".....",
+				".WB..",
+				".B.B.",
+				"..BW.",
+				".....",
+			},
+			pt:  point.New(2, 2),
+			exp: &Eye{Point: point.New(2, 2), Color: color.Black, Kind: FalseEye},
+		},
+		{
+			desc: "corner, opposing diagonal",
+			rows: []string{
+				".W..",
+				"WB..",
+				"....",
+			},
+			pt:  point.New(0, 0),
+			exp: &Eye{Point: point.New(0, 0), Color: color.White, Kind: FalseEye},
+		},
+		{
+			desc: "mixed neighbors",
+			rows: []string{
+				".W.",
+				"B..",
+				"...",
+			},
+			pt: point.New(0, 0),
+		},
+		{
+			desc: "occupied",
+			rows: []string{
+				"BW.",
+				"...",
+			},
+			pt: point.New(0, 0),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := EyeAt(boardtest.FromDiagram(t, tc.rows...), tc.pt)
+			if !reflect.DeepEqual(got, tc.exp) {
+				t.Errorf("got eye %+v, expected %+v", got, tc.exp)
+			}
+		})
+	}
+}
+
+func TestEyes(t *testing.T) {
+	b := boardtest.FromDiagram(t,
+		".B.B.W.",
+		"BBBBBW.",
+		"WWWWWW.")
+	got := Eyes(b, color.Black)
+	if len(got) != 2 {
+		t.Fatalf("got %d eyes, expected 2: %v", len(got), got)
+	}
+	for i, pt := range []*point.Point{point.New(0, 0), point.New(2, 0)} {
+		if !got[i].Point.Equal(pt) || got[i].Kind != RealEye {
+			t.Errorf("got eye %+v, expected a real eye at %v", got[i], pt)
+		}
+	}
+	if kind := EyeKind(FalseEye).String(); kind != "false" {
+		t.Errorf("got %q, expected %q", kind, "false")
+	}
+}
@@ -0,0 +1,44 @@
+// Package life contains life-and-death analysis for board positions: the
+// regions enclosed by each player, real and false eyes, unconditionally alive
+// chains (via Benson's algorithm), and seki.
+package life
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// A Region is an area enclosed by one color: a maximal orthogonally connected
+// set of points that don't contain a stone of the enclosing color. This is the
+// "enclosed region" of Benson's algorithm: the region may contain empty points
+// as well as the opponent's stones.
+type Region struct {
+	*board.Area
+}
+
+// IsVitalTo indicates whether the region is vital to chain c: every empty
+// point in the region is a liberty of c.
+func (r *Region) IsVitalTo(c *board.Chain) bool {
+	libs := make(map[point.Point]bool)
+	for _, lib := range c.Liberties {
+		libs[*lib] = true
+	}
+	for _, pt := range r.Empty {
+		if !libs[*pt] {
+			return false
+		}
+	}
+	return true
+}
+
+// Regions partitions the points that don't contain stones of color c into
+// the regions enclosed by c, ordered by their first point. Regions that
+// border the same chain share the same *board.Chain.
+func Regions(b *board.Board, c color.Color) []*Region {
+	var out []*Region
+	for _, a := range b.Areas(c) {
+		out = append(out, &Region{Area: a})
+	}
+	return out
+}
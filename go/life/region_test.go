@@ -0,0 +1,64 @@
+package life
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/board/boardtest"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestRegions(t *testing.T) {
+	b := boardtest.FromDiagram(t,
+		"..B..",
+		".WB..",
+		"..B..",
+		"BBB..",
+		".....")
+
+	regions := Regions(b, color.Black)
+	if len(regions) != 2 {
+		t.Fatalf("got %d regions, expected 2: %v", len(regions), regions)
+	}
+
+	inner := regions[0]
+	if len(inner.Points) != 6 || len(inner.Empty) != 5 {
+		t.Errorf("got inner region with %d points and %d empty points, expected 6 and 5", len(inner.Points), len(inner.Empty))
+	}
+	if !inner.Points[0].Equal(point.New(0, 0)) {
+		t.Errorf("got first point %v, expected {0,0}", inner.Points[0])
+	}
+	if len(inner.Chains) != 1 || inner.Chains[0].Color != color.Black {
+		t.Errorf("got bordering chains %v, expected the black wall", inner.Chains)
+	}
+
+	outer := regions[1]
+	if len(outer.Points) != 13 {
+		t.Errorf("got outer region with %d points, expected 13", len(outer.Points))
+	}
+	if inner.IsVitalTo(inner.Chains[0]) {
+		t.Errorf("got inner region vital to the wall, expected not vital")
+	}
+}
+
+func TestRegion_IsVitalTo(t *testing.T) {
+	b := boardtest.FromDiagram(t,
+		".B.B.",
+		"BBBBB",
+		".....",
+		".....")
+	regions := Regions(b, color.Black)
+	chain := b.ChainAt(point.New(1, 1))
+
+	var vital int
+	for _, r := range regions {
+		if r.IsVitalTo(chain) {
+			vital++
+		}
+	}
+	// The three single-point regions on the top edge are vital, but the
+	// bottom region isn't, because its last row isn't made of liberties.
+	if vital != 3 {
+		t.Errorf("got %d vital regions, expected 3", vital)
+	}
+}
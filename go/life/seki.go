@@ -0,0 +1,158 @@
+package life
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// Seki is a group of Black and White chains that live together because they
+// share liberties that neither player can fill without putting their own
+// chain in atari.
+type Seki struct {
+	// Chains contains the chains of both colors in the seki, ordered by their
+	// first stone.
+	Chains []*board.Chain
+
+	// Shared contains the shared liberties, sorted by x, then y.
+	Shared []*point.Point
+}
+
+// FindSeki returns the seki shapes on the board, ordered by their first
+// chain. A set of chains forms a seki when:
+//
+//   - the chains include both colors and are connected by shared liberties,
+//   - playing on a shared liberty is illegal or self-atari for both players,
+//   - none of the chains is in atari, and
+//   - each liberty of each chain is either a shared liberty or an eye of the
+//     chain's color.
+//
+// This recognizes the common seki shapes, but not every seki that can arise
+// (such as those that depend on a ko).
+func FindSeki(b *board.Board) []*Seki {
+	chains := b.Chains()
+
+	// Find the shared liberties, which are liberties of both a Black and a
+	// White chain, and the chains that each of them touches.
+	libChains := make(map[point.Point][]int)
+	libColors := make(map[point.Point]map[color.Color]bool)
+	var libs []*point.Point
+	for ci, ch := range chains {
+		for _, lib := range ch.Liberties {
+			if _, ok := libColors[*lib]; !ok {
+				libColors[*lib] = make(map[color.Color]bool)
+				libs = append(libs, lib)
+			}
+			libColors[*lib][ch.Color] = true
+			libChains[*lib] = append(libChains[*lib], ci)
+		}
+	}
+	point.SortList(libs)
+
+	// Keep the shared liberties that neither player can fill.
+	shared := make(map[point.Point]bool)
+	var sharedPts []*point.Point
+	for _, pt := range libs {
+		cols := libColors[*pt]
+		if cols[color.Black] && cols[color.White] && selfAtari(b, pt, color.Black) && selfAtari(b, pt, color.White) {
+			shared[*pt] = true
+			sharedPts = append(sharedPts, pt)
+		}
+	}
+
+	// Group the chains connected by shared liberties, using union-find over
+	// the chain indices.
+	parent := make([]int, len(chains))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	touched := make(map[int]bool)
+	for _, pt := range sharedPts {
+		cis := libChains[*pt]
+		for _, ci := range cis {
+			touched[ci] = true
+			parent[find(ci)] = find(cis[0])
+		}
+	}
+
+	groups := make(map[int][]int)
+	var roots []int
+	for i := range chains {
+		if !touched[i] {
+			continue
+		}
+		r := find(i)
+		if _, ok := groups[r]; !ok {
+			roots = append(roots, r)
+		}
+		groups[r] = append(groups[r], i)
+	}
+
+	state := b.FullBoardState()
+	var out []*Seki
+	for _, r := range roots {
+		var group []*board.Chain
+		for _, ci := range groups[r] {
+			group = append(group, chains[ci])
+		}
+		if s := seki(b, state, group, shared); s != nil {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// seki returns the seki formed by the given chains, or nil if they don't form
+// a seki. State is the full board state of b.
+func seki(b *board.Board, state [][]color.Color, chains []*board.Chain, shared map[point.Point]bool) *Seki {
+	s := &Seki{}
+	var black, white bool
+	sharedSeen := make(map[point.Point]bool)
+	for _, ch := range chains {
+		if ch.InAtari() {
+			return nil
+		}
+		switch ch.Color {
+		case color.Black:
+			black = true
+		case color.White:
+			white = true
+		}
+		for _, lib := range ch.Liberties {
+			if shared[*lib] {
+				if !sharedSeen[*lib] {
+					sharedSeen[*lib] = true
+					s.Shared = append(s.Shared, lib)
+				}
+				continue
+			}
+			if e := eyeAt(b, state, lib); e == nil || e.Color != ch.Color {
+				return nil
+			}
+		}
+		s.Chains = append(s.Chains, ch)
+	}
+	if !black || !white {
+		return nil
+	}
+	point.SortList(s.Shared)
+	return s
+}
+
+// selfAtari indicates whether playing at pt is illegal for color c, or leaves
+// the resulting chain with at most one liberty.
+func selfAtari(b *board.Board, pt *point.Point, c color.Color) bool {
+	b = b.Clone()
+	if _, err := b.PlaceStone(move.New(c, pt)); err != nil {
+		return true
+	}
+	return b.LibertyCount(pt) <= 1
+}
@@ -0,0 +1,84 @@
+package life
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board/boardtest"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestFindSeki(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		rows         []string
+		expChains    []*point.Point
+		expShared    [][]*point.Point
+		expNumChains []int
+	}{
+		{
+			desc: "one eye each",
+			rows: []string{
+				".B.W.W",
+				"BBBWWW",
+			},
+			expChains:    []*point.Point{point.New(0, 1)},
+			expShared:    [][]*point.Point{{point.New(2, 0)}},
+			expNumChains: []int{2},
+		},
+		{
+			desc: "no eyes, two shared liberties",
+			rows: []string{
+				"W.B",
+				"W.B",
+				"WBB",
+			},
+			expChains:    []*point.Point{point.New(0, 0)},
+			expShared:    [][]*point.Point{{point.New(1, 0), point.New(1, 1)}},
+			expNumChains: []int{2},
+		},
+		{
+			desc: "eye against no eye isn't seki",
+			rows: []string{
+				"W.B.",
+				"W.BB",
+				"WWWB",
+				"BBBB",
+			},
+		},
+		{
+			desc: "dead group isn't seki",
+			rows: []string{
+				".B.W.",
+				"BBBW.",
+				"WWWW.",
+			},
+		},
+		{
+			desc: "no stones",
+			rows: []string{
+				"...",
+				"...",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := FindSeki(boardtest.FromDiagram(t, tc.rows...))
+			if len(got) != len(tc.expChains) {
+				t.Fatalf("got %d seki, expected %d: %+v", len(got), len(tc.expChains), got)
+			}
+			for i, s := range got {
+				if !s.Chains[0].Stones[0].Equal(tc.expChains[i]) {
+					t.Errorf("got seki starting at %v, expected %v", s.Chains[0].Stones[0], tc.expChains[i])
+				}
+				if len(s.Chains) != tc.expNumChains[i] {
+					t.Errorf("got %d chains, expected %d", len(s.Chains), tc.expNumChains[i])
+				}
+				if !reflect.DeepEqual(s.Shared, tc.expShared[i]) {
+					t.Errorf("got shared liberties %v, expected %v", s.Shared, tc.expShared[i])
+				}
+			}
+		})
+	}
+}
@@ -65,14 +65,16 @@ func FromSGFPoint(col color.Color, sgfPt string) (*Move, error) {
 }
 
 // ListFromSGFPoints a move list of the form "ab", "bc" to a moves of the form
-// {0,1}, {0,2}. Note that pass-moves are not allowed in move-lists.
+// {0,1}, {0,2}. Compressed point lists of the form "aa:bc" are expanded into
+// the points of the rectangle. Note that pass-moves are not allowed in
+// move-lists.
 func ListFromSGFPoints(col color.Color, sgfPts []string) ([]*Move, error) {
+	pts, err := point.ListFromSGF(sgfPts)
+	if err != nil {
+		return nil, err
+	}
 	var moves []*Move
-	for _, sgfPt := range sgfPts {
-		pt, err := point.NewFromSGF(sgfPt)
-		if err != nil {
-			return nil, err
-		}
+	for _, pt := range pts {
 		moves = append(moves, &Move{
 			color: col,
 			point: pt,
@@ -116,6 +116,22 @@ func TestListFromSGFPoints(t *testing.T) {
 			col:       color.White,
 			exp:       []*Move{New(color.White, point.New(0, 1)), New(color.White, point.New(2, 3)), New(color.White, point.New(4, 4))},
 		},
+		{
+			desc:      "Compressed List",
+			sgfPtList: []string{"aa:bb", "dd"},
+			col:       color.Black,
+			exp: []*Move{
+				New(color.Black, point.New(0, 0)), New(color.Black, point.New(1, 0)),
+				New(color.Black, point.New(0, 1)), New(color.Black, point.New(1, 1)),
+				New(color.Black, point.New(3, 3)),
+			},
+		},
+		{
+			desc:      "Reversed Compressed List",
+			sgfPtList: []string{"bb:aa"},
+			col:       color.Black,
+			expErr:    point.SGFConversionErr,
+		},
 		{
 			desc:      "Empty Move List",
 			sgfPtList: []string{},
@@ -170,6 +186,9 @@ func TestListFromSGFPoints(t *testing.T) {
 				return
 			}
 
+			if len(got) != len(tc.exp) {
+				t.Fatalf("got %d moves, expected %d", len(got), len(tc.exp))
+			}
 			for i, exp := range tc.exp {
 				if got[i].color != exp.color || got[i].point.X() != exp.point.X() || got[i].point.Y() != exp.point.Y() {
 					t.Errorf("got %v%v, expected %v%v", got[i].color, got[i].point, exp.color, exp.point)
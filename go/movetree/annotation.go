@@ -0,0 +1,134 @@
+package movetree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrAnnotation indicates that an annotation couldn't be serialized or
+// deserialized.
+var ErrAnnotation = errors.New("error with annotation")
+
+// Key identifies a typed annotation attached to nodes, such as the analysis
+// from an engine, visit counts, or notes from a reviewer. Each source of
+// annotations uses its own key, so that annotations from different sources
+// don't clobber each other.
+//
+// The type parameter is the type of the annotation's values, so that reading
+// an annotation doesn't need a type assertion:
+//
+//	var visitsKey = movetree.NewKey[int]("visits")
+//
+//	visitsKey.Set(n, 3)
+//	visits, ok := visitsKey.Get(n)
+type Key[T any] struct {
+	name string
+}
+
+// NewKey creates a Key with the given name. The name identifies the
+// annotation when serialized, and so should be unique and stable.
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{name: name}
+}
+
+// Name returns the name of the key.
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+// Get gets the annotation for this key from a node, returning false if the
+// node doesn't have one.
+func (k Key[T]) Get(n *Node) (T, bool) {
+	v, ok := n.annotations[k.name].(T)
+	return v, ok
+}
+
+// Set sets the annotation for this key on a node, replacing any previous
+// value.
+func (k Key[T]) Set(n *Node, v T) {
+	if n.annotations == nil {
+		n.annotations = make(map[string]interface{})
+	}
+	n.annotations[k.name] = v
+}
+
+// Delete removes the annotation for this key from a node.
+func (k Key[T]) Delete(n *Node) {
+	delete(n.annotations, k.name)
+}
+
+// UnmarshalAnnotation sets the annotation for this key on a node from its
+// JSON encoding.
+func (k Key[T]) UnmarshalAnnotation(n *Node, data []byte) error {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("%w: for key %q: %v", ErrAnnotation, k.name, err)
+	}
+	k.Set(n, v)
+	return nil
+}
+
+// AnnotationKey is a Key of any type, for working with annotations without
+// knowing their types, such as when deserializing.
+type AnnotationKey interface {
+	Name() string
+	UnmarshalAnnotation(n *Node, data []byte) error
+}
+
+// AnnotationNames returns the names of the annotations on the node, in sorted
+// order.
+func (n *Node) AnnotationNames() []string {
+	var names []string
+	for name := range n.annotations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CopyAnnotations copies the annotations from src to n, replacing the
+// annotations on n with the same keys. The values themselves are not copied.
+func (n *Node) CopyAnnotations(src *Node) {
+	for name, v := range src.annotations {
+		if n.annotations == nil {
+			n.annotations = make(map[string]interface{})
+		}
+		n.annotations[name] = v
+	}
+}
+
+// MarshalAnnotations returns the JSON encoding of the node's annotations, as
+// an object from the key names to the values.
+func (n *Node) MarshalAnnotations() ([]byte, error) {
+	if len(n.annotations) == 0 {
+		return []byte("{}"), nil
+	}
+	data, err := json.Marshal(n.annotations)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAnnotation, err)
+	}
+	return data, nil
+}
+
+// UnmarshalAnnotations sets annotations on the node from their JSON encoding,
+// as produced by MarshalAnnotations. Since the encoding doesn't contain the
+// types of the values, only the annotations for the given keys are set; the
+// others are ignored.
+func (n *Node) UnmarshalAnnotations(data []byte, keys ...AnnotationKey) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%w: %v", ErrAnnotation, err)
+	}
+	for _, k := range keys {
+		v, ok := raw[k.Name()]
+		if !ok {
+			continue
+		}
+		if err := k.UnmarshalAnnotation(n, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
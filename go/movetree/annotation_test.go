@@ -0,0 +1,117 @@
+package movetree
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type engineResult struct {
+	Winrate float64
+	Moves   []string
+}
+
+var (
+	visitsKey = NewKey[int]("visits")
+	engineKey = NewKey[*engineResult]("engine")
+	noteKey   = NewKey[string]("note")
+)
+
+func TestAnnotations(t *testing.T) {
+	n := NewNode()
+	if _, ok := visitsKey.Get(n); ok {
+		t.Errorf("got visits on a new node, expected none")
+	}
+
+	visitsKey.Set(n, 3)
+	engineKey.Set(n, &engineResult{Winrate: 0.6})
+	if got, ok := visitsKey.Get(n); !ok || got != 3 {
+		t.Errorf("got visits %d, %v, expected 3", got, ok)
+	}
+	if got, ok := engineKey.Get(n); !ok || got.Winrate != 0.6 {
+		t.Errorf("got engine result %v, %v, expected winrate 0.6", got, ok)
+	}
+	if got, exp := n.AnnotationNames(), []string{"engine", "visits"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("got names %v, expected %v", got, exp)
+	}
+
+	// A key with the same name but a different type doesn't see the value.
+	if _, ok := NewKey[string]("visits").Get(n); ok {
+		t.Errorf("got a string for the visits key, expected none")
+	}
+
+	visitsKey.Set(n, 4)
+	if got, _ := visitsKey.Get(n); got != 4 {
+		t.Errorf("got visits %d after replacing, expected 4", got)
+	}
+	visitsKey.Delete(n)
+	if _, ok := visitsKey.Get(n); ok {
+		t.Errorf("got visits after deleting, expected none")
+	}
+	if _, ok := engineKey.Get(n); !ok {
+		t.Errorf("got no engine result after deleting visits, expected it to be kept")
+	}
+}
+
+func TestCopyAnnotations(t *testing.T) {
+	src := NewNode()
+	visitsKey.Set(src, 3)
+	noteKey.Set(src, "src")
+
+	dst := NewNode()
+	noteKey.Set(dst, "dst")
+	engineKey.Set(dst, &engineResult{})
+	dst.CopyAnnotations(src)
+
+	if got, _ := visitsKey.Get(dst); got != 3 {
+		t.Errorf("got visits %d, expected 3", got)
+	}
+	if got, _ := noteKey.Get(dst); got != "src" {
+		t.Errorf("got note %q, expected it to be replaced with %q", got, "src")
+	}
+	if _, ok := engineKey.Get(dst); !ok {
+		t.Errorf("got no engine result, expected it to be kept")
+	}
+}
+
+func TestMarshalAnnotations(t *testing.T) {
+	n := NewNode()
+	data, err := n.MarshalAnnotations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "{}" {
+		t.Errorf("got %s for no annotations, expected {}", data)
+	}
+
+	visitsKey.Set(n, 3)
+	engineKey.Set(n, &engineResult{Winrate: 0.5, Moves: []string{"D4", "Q16"}})
+	noteKey.Set(n, "good shape")
+	data, err = n.MarshalAnnotations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `{"engine":{"Winrate":0.5,"Moves":["D4","Q16"]},"note":"good shape","visits":3}`
+	if string(data) != exp {
+		t.Errorf("got %s, expected %s", data, exp)
+	}
+
+	// Only the annotations for the given keys are read back.
+	nn := NewNode()
+	if err := nn.UnmarshalAnnotations(data, visitsKey, engineKey); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := visitsKey.Get(nn); got != 3 {
+		t.Errorf("got visits %d, expected 3", got)
+	}
+	if got, _ := engineKey.Get(nn); !reflect.DeepEqual(got, &engineResult{Winrate: 0.5, Moves: []string{"D4", "Q16"}}) {
+		t.Errorf("got engine result %+v, expected the marshaled result", got)
+	}
+	if got, exp := nn.AnnotationNames(), []string{"engine", "visits"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("got names %v, expected %v", got, exp)
+	}
+
+	if err := nn.UnmarshalAnnotations([]byte(`{"visits":"three"}`), visitsKey); !errors.Is(err, ErrAnnotation) {
+		t.Errorf("got error %v for a bad value, expected %v", err, ErrAnnotation)
+	}
+}
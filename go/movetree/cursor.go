@@ -0,0 +1,239 @@
+package movetree
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+)
+
+// ErrCursorMove indicates that a cursor can't make the requested move through
+// the tree.
+var ErrCursorMove = errors.New("error moving cursor")
+
+// Cursor walks through a MoveTree, tracking the current node, its path from
+// the root, and the board position at the node.
+//
+// The board is maintained incrementally: moving forward places the node's
+// stones, and moving back undoes them, so that stepping through a game never
+// replays it from the root. Nodes with placements (which set or clear
+// individual points, without captures) save a copy of the board before them,
+// since they can't be undone with the board's move history.
+type Cursor struct {
+	root  *Node
+	node  *Node
+	path  Path
+	board *board.Board
+
+	// frames contains the undo information for each node after the root on
+	// the path to the current node.
+	frames []*cursorFrame
+
+	// captures contains the stones captured by the current node's move.
+	captures move.List
+}
+
+// cursorFrame is the information needed to take back a node.
+type cursorFrame struct {
+	// snapshot is the board before the node, if the node had placements.
+	snapshot *board.Board
+
+	// placed indicates that the node's move was placed, and so is taken back
+	// with board.Undo.
+	placed bool
+
+	// parent is the node before this one. It's recorded rather than read from
+	// the node's Parent, so that the cursor also works on trees whose Children
+	// were assigned directly.
+	parent *Node
+
+	// prevCaptures are the captures of the parent node.
+	prevCaptures move.List
+}
+
+// NewCursor creates a Cursor at the root of a movetree, using a board with
+// the dimensions and rules from the game info. The root's stones are placed on
+// the board.
+func NewCursor(mt *MoveTree) (*Cursor, error) {
+	b := board.NewRect(dimensions(mt.Root))
+	b.SetRules(boardRules(mt.Root))
+	return NewCursorWithBoard(mt, b)
+}
+
+// NewCursorWithBoard creates a Cursor at the root of a movetree, starting from
+// a copy of the given board. This allows, for example, using board rules
+// other than the defaults.
+func NewCursorWithBoard(mt *MoveTree, b *board.Board) (*Cursor, error) {
+	b = b.Clone()
+	b.EnableHistory()
+	c := &Cursor{
+		root:  mt.Root,
+		node:  mt.Root,
+		path:  Path{},
+		board: b,
+	}
+	if err := b.SetPlacements(mt.Root.Placements); err != nil {
+		return nil, fmt.Errorf("%w: at root: %v", ErrCursorMove, err)
+	}
+	if mv := mt.Root.Move; mv != nil && mv.Color() != color.Empty {
+		captures, err := b.PlaceStone(mv)
+		if err != nil {
+			return nil, fmt.Errorf("%w: at root: %v", ErrCursorMove, err)
+		}
+		c.captures = captures
+	}
+	return c, nil
+}
+
+// Node returns the current node.
+func (c *Cursor) Node() *Node {
+	return c.node
+}
+
+// Path returns (a copy of) the path from the root to the current node.
+func (c *Cursor) Path() Path {
+	return c.path.Clone()
+}
+
+// Board returns the board position at the current node. The board is updated
+// in place as the cursor moves, and shouldn't be modified; Clone it to make
+// changes.
+func (c *Cursor) Board() *board.Board {
+	return c.board
+}
+
+// Captures returns the stones captured by the current node's move.
+func (c *Cursor) Captures() move.List {
+	return c.captures
+}
+
+// AtRoot indicates whether the cursor is at the root.
+func (c *Cursor) AtRoot() bool {
+	return c.node == c.root
+}
+
+// AtEnd indicates whether the current node has no children.
+func (c *Cursor) AtEnd() bool {
+	return len(c.node.Children) == 0
+}
+
+// Forward moves to the first (main) variation of the current node.
+func (c *Cursor) Forward() error {
+	return c.ToBranch(0)
+}
+
+// ToBranch moves to the given variation of the current node. If there's no
+// such variation, or the node's stones can't be placed, the cursor doesn't
+// move.
+func (c *Cursor) ToBranch(variation int) error {
+	if variation < 0 || variation >= len(c.node.Children) {
+		return fmt.Errorf("%w: no variation %d at path %v", ErrCursorMove, variation, c.path)
+	}
+	next := c.node.Children[variation]
+
+	f := &cursorFrame{parent: c.node, prevCaptures: c.captures}
+	if len(next.Placements) > 0 {
+		f.snapshot = c.board.Clone()
+		if err := c.board.SetPlacements(next.Placements); err != nil {
+			c.restore(f.snapshot)
+			return fmt.Errorf("%w: at path %v: %v", ErrCursorMove, append(c.path.Clone(), variation), err)
+		}
+	}
+	var captures move.List
+	if mv := next.Move; mv != nil && mv.Color() != color.Empty {
+		var err error
+		captures, err = c.board.PlaceStone(mv)
+		if err != nil {
+			if f.snapshot != nil {
+				c.restore(f.snapshot)
+			}
+			return fmt.Errorf("%w: at path %v: %v", ErrCursorMove, append(c.path.Clone(), variation), err)
+		}
+		f.placed = true
+	}
+
+	c.frames = append(c.frames, f)
+	c.path = append(c.path, variation)
+	c.node = next
+	c.captures = captures
+	return nil
+}
+
+// Back moves to the parent of the current node.
+func (c *Cursor) Back() error {
+	if c.AtRoot() {
+		return fmt.Errorf("%w: already at the root", ErrCursorMove)
+	}
+	f := c.frames[len(c.frames)-1]
+	if f.snapshot != nil {
+		c.restore(f.snapshot)
+	} else if f.placed {
+		if _, err := c.board.Undo(); err != nil {
+			return fmt.Errorf("%w: at path %v: %v", ErrCursorMove, c.path, err)
+		}
+	}
+
+	c.frames = c.frames[:len(c.frames)-1]
+	c.path = c.path[:len(c.path)-1]
+	c.node = f.parent
+	c.captures = f.prevCaptures
+	return nil
+}
+
+// ToRoot moves back to the root.
+func (c *Cursor) ToRoot() error {
+	for !c.AtRoot() {
+		if err := c.Back(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToEnd moves forward along the first variation of each node until reaching
+// a node without children.
+func (c *Cursor) ToEnd() error {
+	for !c.AtEnd() {
+		if err := c.Forward(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JumpTo moves to the node at the given path from the root. Only the nodes
+// that differ between the current path and the new path are taken back and
+// replayed. If the path doesn't exist in the tree, the cursor doesn't move.
+func (c *Cursor) JumpTo(p Path) error {
+	n := c.root
+	for i, v := range p {
+		if v < 0 || v >= len(n.Children) {
+			return fmt.Errorf("%w: no variation %d at path %v", ErrCursorMove, v, p[:i])
+		}
+		n = n.Children[v]
+	}
+
+	common := 0
+	for common < len(p) && common < len(c.path) && p[common] == c.path[common] {
+		common++
+	}
+	for len(c.path) > common {
+		if err := c.Back(); err != nil {
+			return err
+		}
+	}
+	for _, v := range p[common:] {
+		if err := c.ToBranch(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restore replaces the board with a saved copy, keeping the board pointer
+// stable for callers of Board.
+func (c *Cursor) restore(snapshot *board.Board) {
+	*c.board = *snapshot
+}
@@ -0,0 +1,221 @@
+package movetree_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/sgf"
+)
+
+// checkCursorBoard compares the cursor's board against the board from
+// replaying the cursor's path from the root.
+func checkCursorBoard(t *testing.T, mt *movetree.MoveTree, c *movetree.Cursor) {
+	t.Helper()
+	exp, _, err := c.Path().ApplyToBoard(mt.Root, board.NewRect(mt.Root.GameInfo.Dimensions()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := c.Board()
+	if !reflect.DeepEqual(got.FullBoardState(), exp.FullBoardState()) {
+		t.Errorf("at path %v, got board\n%v\nexpected\n%v", c.Path(), got, exp)
+	}
+	if !reflect.DeepEqual(got.Ko(), exp.Ko()) {
+		t.Errorf("at path %v, got ko %v, expected %v", c.Path(), got.Ko(), exp.Ko())
+	}
+	for _, col := range []color.Color{color.Black, color.White} {
+		if got.Prisoners(col) != exp.Prisoners(col) {
+			t.Errorf("at path %v, got %d %v prisoners, expected %d", c.Path(), got.Prisoners(col), col, exp.Prisoners(col))
+		}
+	}
+}
+
+func TestCursor(t *testing.T) {
+	mt, err := sgf.Parse(`(;GM[1]SZ[5]AB[cc]
+		;B[ba];W[aa];B[ab]
+		(;W[bb];B[ca])
+		(;W[ac]AW[dd]AB[ee];B[bc]))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := movetree.NewCursor(mt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkCursorBoard(t, mt, c)
+
+	if !c.AtRoot() {
+		t.Errorf("got cursor not at root, expected at root")
+	}
+	if err := c.Back(); !errors.Is(err, movetree.ErrCursorMove) {
+		t.Errorf("got error %v going back from the root, expected %v", err, movetree.ErrCursorMove)
+	}
+
+	// B[ab] captures the white stone at aa.
+	for i := 0; i < 3; i++ {
+		if err := c.Forward(); err != nil {
+			t.Fatal(err)
+		}
+		checkCursorBoard(t, mt, c)
+	}
+	if len(c.Captures()) != 1 || c.Captures()[0].Color() != color.White {
+		t.Errorf("got captures %v, expected the white stone at aa", c.Captures())
+	}
+
+	if err := c.ToBranch(2); !errors.Is(err, movetree.ErrCursorMove) {
+		t.Errorf("got error %v for a missing variation, expected %v", err, movetree.ErrCursorMove)
+	}
+	if err := c.ToBranch(-1); !errors.Is(err, movetree.ErrCursorMove) {
+		t.Errorf("got error %v for a negative variation, expected %v", err, movetree.ErrCursorMove)
+	}
+	if got := c.Path(); !reflect.DeepEqual(got, movetree.Path{0, 0, 0}) {
+		t.Errorf("got path %v after a failed move, expected [0 0 0]", got)
+	}
+
+	// The second variation has placements, which are taken back using a
+	// copy of the board.
+	if err := c.ToBranch(1); err != nil {
+		t.Fatal(err)
+	}
+	checkCursorBoard(t, mt, c)
+	if err := c.ToEnd(); err != nil {
+		t.Fatal(err)
+	}
+	checkCursorBoard(t, mt, c)
+	if !c.AtEnd() || c.Node().Move.Color() != color.Black {
+		t.Errorf("got node %v, expected the final black move", c.Node().Move)
+	}
+	b := c.Board()
+	for i := 0; i < 2; i++ {
+		if err := c.Back(); err != nil {
+			t.Fatal(err)
+		}
+		checkCursorBoard(t, mt, c)
+	}
+	if c.Board() != b {
+		t.Errorf("got a new board after going back, expected the board to be updated in place")
+	}
+	if len(c.Captures()) != 1 {
+		t.Errorf("got captures %v after going back, expected the capture at B[ab]", c.Captures())
+	}
+
+	if err := c.JumpTo(movetree.Path{0, 0, 0, 0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	checkCursorBoard(t, mt, c)
+	if err := c.JumpTo(movetree.Path{0, 0, 0, 1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	checkCursorBoard(t, mt, c)
+	if err := c.JumpTo(movetree.Path{0, 3}); !errors.Is(err, movetree.ErrCursorMove) {
+		t.Errorf("got error %v jumping to a missing path, expected %v", err, movetree.ErrCursorMove)
+	}
+	if got := c.Path(); !reflect.DeepEqual(got, movetree.Path{0, 0, 0, 1, 0}) {
+		t.Errorf("got path %v after a failed jump, expected [0 0 0 1 0]", got)
+	}
+
+	if err := c.ToRoot(); err != nil {
+		t.Fatal(err)
+	}
+	checkCursorBoard(t, mt, c)
+	if c.Node() != mt.Root {
+		t.Errorf("got a node other than the root after ToRoot")
+	}
+}
+
+func TestCursor_IllegalMove(t *testing.T) {
+	mt, err := sgf.Parse(`(;GM[1]SZ[5];B[aa];W[aa])`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := movetree.NewCursor(mt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ToEnd(); !errors.Is(err, movetree.ErrCursorMove) {
+		t.Errorf("got error %v, expected %v", err, movetree.ErrCursorMove)
+	}
+	if got := c.Path(); !reflect.DeepEqual(got, movetree.Path{0}) {
+		t.Errorf("got path %v, expected the cursor to stop before the illegal move", got)
+	}
+	checkCursorBoard(t, mt, c)
+}
+
+// tripleKo is a game with three kos, in which the players take the kos in turn.
+// The position repeats every six moves, without breaking the simple ko rule.
+const tripleKo = `(;GM[1]SZ[13]RU[%s]
+	AB[ba][ab][bc][ce][df][cg][bf][bi][aj][bk]
+	AW[ca][db][cc][bb][be][af][bg][ci][dj][ck][bj]
+	;B[cb];W[cf];B[cj];W[bb];B[bf];W[bj]
+	;B[cb];W[cf];B[cj];W[bb];B[bf];W[bj])`
+
+func TestCursor_TripleKo(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		rules   string
+		expMove int
+		expErr  error
+	}{
+		{desc: "unspecified rules", expMove: 12},
+		{desc: "simple ko", rules: "Japanese", expMove: 12},
+		{desc: "chinese simple ko", rules: "Chinese", expMove: 12},
+		// The sixth move repeats the starting position.
+		{desc: "positional superko", rules: "Chinese-OGS", expMove: 5, expErr: movetree.ErrCursorMove},
+		{desc: "situational superko", rules: "NZ", expMove: 5, expErr: movetree.ErrCursorMove},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mt, err := sgf.Parse(fmt.Sprintf(tripleKo, tc.rules))
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := movetree.NewCursor(mt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.ToEnd(); !errors.Is(err, tc.expErr) {
+				t.Errorf("got error %v, expected %v", err, tc.expErr)
+			}
+			if got := c.Node().MoveNum(); got != tc.expMove {
+				t.Errorf("got cursor at move %d, expected %d", got, tc.expMove)
+			}
+		})
+	}
+}
+
+func TestCursor_TestDatabase(t *testing.T) {
+	files, err := filepath.Glob("../../test-database/*.sgf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		content, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mt, err := sgf.Parse(string(content))
+		if err != nil {
+			continue
+		}
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			c, err := movetree.NewCursor(mt)
+			if err != nil {
+				t.Skip(err)
+			}
+			if err := c.ToEnd(); err != nil {
+				t.Skip(err)
+			}
+			checkCursorBoard(t, mt, c)
+			if err := c.ToRoot(); err != nil {
+				t.Fatal(err)
+			}
+			checkCursorBoard(t, mt, c)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package movetree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEditTree indicates that a tree edit is invalid.
+var ErrEditTree = errors.New("error editing tree")
+
+// Delete removes the node, along with its subtree, from its parent. Later
+// variations of the parent move up to fill the gap. It's an error to delete
+// a node without a parent (such as the root).
+func (n *Node) Delete() error {
+	p := n.Parent
+	if p == nil {
+		return fmt.Errorf("%w: can't delete a node without a parent", ErrEditTree)
+	}
+	_, err := p.RemoveChild(n.varNum)
+	return err
+}
+
+// RemoveChild cuts out the child at the given variation, along with its
+// subtree, and returns it so it can be pasted elsewhere with InsertChild or
+// AddChild. Later variations move up to fill the gap.
+func (n *Node) RemoveChild(variation int) (*Node, error) {
+	if err := n.checkVariation(variation, len(n.Children)-1); err != nil {
+		return nil, err
+	}
+	child := n.Children[variation]
+	n.Children = append(n.Children[:variation], n.Children[variation+1:]...)
+	child.Parent = nil
+	for v := variation; v < len(n.Children); v++ {
+		n.Children[v].varNum = v
+	}
+	return child, nil
+}
+
+// InsertChild pastes a subtree as the child at the given variation; the
+// existing variations from that index onward move down by one. A variation
+// equal to the number of children appends the subtree, as with AddChild.
+//
+// The subtree must be detached (it must not have a parent), and must not
+// contain n.
+func (n *Node) InsertChild(variation int, nn *Node) error {
+	if err := n.checkVariation(variation, len(n.Children)); err != nil {
+		return err
+	}
+	if err := n.checkPaste(nn); err != nil {
+		return err
+	}
+	n.Children = append(n.Children, nil)
+	copy(n.Children[variation+1:], n.Children[variation:])
+	n.Children[variation] = nn
+	nn.Parent = n
+	for v := variation + 1; v < len(n.Children); v++ {
+		n.Children[v].varNum = v
+	}
+	n.renumberChild(variation)
+	return nil
+}
+
+// InsertBetween inserts a node between n and its child at the given
+// variation: nn takes the child's place, and the child becomes nn's only
+// child. The inserted node must be detached and have no children.
+func (n *Node) InsertBetween(variation int, nn *Node) error {
+	if err := n.checkVariation(variation, len(n.Children)-1); err != nil {
+		return err
+	}
+	if err := n.checkPaste(nn); err != nil {
+		return err
+	}
+	if len(nn.Children) > 0 {
+		return fmt.Errorf("%w: inserted node must not have children", ErrEditTree)
+	}
+	child := n.Children[variation]
+	n.Children[variation] = nn
+	nn.Parent = n
+	nn.Children = []*Node{child}
+	child.Parent = nn
+	n.renumberChild(variation)
+	return nil
+}
+
+// MoveVariation moves the child at variation from to variation to, shifting
+// the variations in between. Moving a variation to 0 makes it the main
+// variation of n.
+func (n *Node) MoveVariation(from, to int) error {
+	if err := n.checkVariation(from, len(n.Children)-1); err != nil {
+		return err
+	}
+	if err := n.checkVariation(to, len(n.Children)-1); err != nil {
+		return err
+	}
+	child := n.Children[from]
+	if from < to {
+		copy(n.Children[from:to], n.Children[from+1:to+1])
+	} else {
+		copy(n.Children[to+1:from+1], n.Children[to:from])
+	}
+	n.Children[to] = child
+	for v, c := range n.Children {
+		c.varNum = v
+	}
+	return nil
+}
+
+// PromoteToMainline makes the node part of the main line: for the node and
+// each of its ancestors, the node on the path is moved to variation 0 of its
+// parent. The order of the other variations is kept.
+func (n *Node) PromoteToMainline() {
+	for cur := n; cur.Parent != nil; cur = cur.Parent {
+		if cur.varNum != 0 {
+			// MoveVariation can't fail here: both indices are valid.
+			_ = cur.Parent.MoveVariation(cur.varNum, 0)
+		}
+	}
+}
+
+// checkVariation checks that a variation is between 0 and last, inclusive.
+func (n *Node) checkVariation(variation, last int) error {
+	if variation < 0 || variation > last {
+		return fmt.Errorf("%w: variation %d is out of range for a node with %d children", ErrEditTree, variation, len(n.Children))
+	}
+	return nil
+}
+
+// checkPaste checks that nn can be pasted under n: it must be detached, and
+// n must not be in its subtree.
+func (n *Node) checkPaste(nn *Node) error {
+	if nn == nil {
+		return fmt.Errorf("%w: can't paste a nil node", ErrEditTree)
+	}
+	if nn.Parent != nil {
+		return fmt.Errorf("%w: pasted node must be detached from its parent", ErrEditTree)
+	}
+	for cur := n; cur != nil; cur = cur.Parent {
+		if cur == nn {
+			return fmt.Errorf("%w: can't paste a node into its own subtree", ErrEditTree)
+		}
+	}
+	return nil
+}
+
+// renumberChild sets the move and variation numbers of the child at the given
+// variation, and the move numbers of its subtree.
+func (n *Node) renumberChild(variation int) {
+	child := n.Children[variation]
+	child.varNum = variation
+	child.moveNum = n.moveNum + 1
+	stack := []*Node{child}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for v, c := range cur.Children {
+			c.moveNum = cur.moveNum + 1
+			c.varNum = v
+			stack = append(stack, c)
+		}
+	}
+}
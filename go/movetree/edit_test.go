@@ -0,0 +1,228 @@
+package movetree
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// buildTree builds a tree for testing from nested labels. Each element is
+// either a label string, or a []interface{} whose first element is a label
+// followed by the children. The labels are stored in the comments.
+func buildTree(spec interface{}) *Node {
+	n := NewNode()
+	switch s := spec.(type) {
+	case string:
+		n.Comment = s
+	case []interface{}:
+		n.Comment = s[0].(string)
+		for _, c := range s[1:] {
+			n.AddChild(buildTree(c))
+		}
+	}
+	return n
+}
+
+// describe returns the labels of the tree, with the children in brackets.
+func describe(n *Node) string {
+	if len(n.Children) == 0 {
+		return n.Comment
+	}
+	var children []string
+	for _, c := range n.Children {
+		children = append(children, describe(c))
+	}
+	return fmt.Sprintf("%s[%s]", n.Comment, strings.Join(children, ","))
+}
+
+// checkNumbers checks the parent pointers and the move and variation numbers
+// of a tree.
+func checkNumbers(t *testing.T, root *Node) {
+	t.Helper()
+	root.Traverse(func(n *Node) {
+		for v, c := range n.Children {
+			if c.Parent != n {
+				t.Errorf("node %s has the wrong parent", c.Comment)
+			}
+			if c.varNum != v {
+				t.Errorf("node %s has variation number %d, expected %d", c.Comment, c.varNum, v)
+			}
+			if c.moveNum != n.moveNum+1 {
+				t.Errorf("node %s has move number %d, expected %d", c.Comment, c.moveNum, n.moveNum+1)
+			}
+		}
+	})
+}
+
+// find returns the node with the given label.
+func find(root *Node, label string) *Node {
+	var out *Node
+	root.Traverse(func(n *Node) {
+		if n.Comment == label {
+			out = n
+		}
+	})
+	return out
+}
+
+func testTree() *Node {
+	return buildTree([]interface{}{"r",
+		[]interface{}{"a", []interface{}{"b", "c"}, "d"},
+		[]interface{}{"e", "f"},
+		"g",
+	})
+}
+
+func TestDelete(t *testing.T) {
+	root := testTree()
+	if err := find(root, "e").Delete(); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := describe(root), "r[a[b[c],d],g]"; got != exp {
+		t.Errorf("got tree %s, expected %s", got, exp)
+	}
+	checkNumbers(t, root)
+
+	if err := root.Delete(); !errors.Is(err, ErrEditTree) {
+		t.Errorf("got error %v deleting the root, expected %v", err, ErrEditTree)
+	}
+}
+
+func TestCutAndPaste(t *testing.T) {
+	root := testTree()
+	noteKey := NewKey[string]("note")
+	noteKey.Set(root.Children[0], "analysis")
+
+	cut, err := root.RemoveChild(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cut.Parent != nil {
+		t.Errorf("got a cut node with a parent, expected it to be detached")
+	}
+	if got, exp := describe(root), "r[e[f],g]"; got != exp {
+		t.Errorf("got tree %s, expected %s", got, exp)
+	}
+	checkNumbers(t, root)
+
+	// Paste the subtree deeper in the tree.
+	if err := find(root, "f").InsertChild(0, cut); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := describe(root), "r[e[f[a[b[c],d]]],g]"; got != exp {
+		t.Errorf("got tree %s, expected %s", got, exp)
+	}
+	checkNumbers(t, root)
+	if c := find(root, "c"); c.MoveNum() != 5 {
+		t.Errorf("got move number %d for the pasted node c, expected 5", c.MoveNum())
+	}
+	if got, _ := noteKey.Get(cut); got != "analysis" {
+		t.Errorf("got annotation %q after pasting, expected it to be kept", got)
+	}
+
+	// Paste a new variation in the middle.
+	if err := root.InsertChild(1, buildTree("h")); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := describe(root), "r[e[f[a[b[c],d]]],h,g]"; got != exp {
+		t.Errorf("got tree %s, expected %s", got, exp)
+	}
+	checkNumbers(t, root)
+}
+
+func TestInsertChild_Errors(t *testing.T) {
+	root := testTree()
+	testCases := []struct {
+		desc      string
+		variation int
+		nn        *Node
+	}{
+		{desc: "attached node", nn: find(root, "b")},
+		{desc: "nil node", nn: nil},
+		{desc: "out of range", variation: 4, nn: NewNode()},
+		{desc: "negative", variation: -1, nn: NewNode()},
+		{desc: "own subtree", nn: root},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := find(root, "c").InsertChild(tc.variation, tc.nn)
+			if !errors.Is(err, ErrEditTree) {
+				t.Errorf("got error %v, expected %v", err, ErrEditTree)
+			}
+			if got, exp := describe(root), "r[a[b[c],d],e[f],g]"; got != exp {
+				t.Errorf("got tree %s after a failed paste, expected %s", got, exp)
+			}
+		})
+	}
+}
+
+func TestInsertBetween(t *testing.T) {
+	root := testTree()
+	if err := root.InsertBetween(1, buildTree("x")); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := describe(root), "r[a[b[c],d],x[e[f]],g]"; got != exp {
+		t.Errorf("got tree %s, expected %s", got, exp)
+	}
+	checkNumbers(t, root)
+	if f := find(root, "f"); f.MoveNum() != 3 {
+		t.Errorf("got move number %d for f, expected 3", f.MoveNum())
+	}
+
+	withChild := buildTree([]interface{}{"y", "z"})
+	if err := root.InsertBetween(0, withChild); !errors.Is(err, ErrEditTree) {
+		t.Errorf("got error %v inserting a node with children, expected %v", err, ErrEditTree)
+	}
+}
+
+func TestMoveVariation(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		from, to int
+		exp      string
+		expErr   error
+	}{
+		{desc: "last to first", from: 2, to: 0, exp: "r[g,a[b[c],d],e[f]]"},
+		{desc: "first to last", from: 0, to: 2, exp: "r[e[f],g,a[b[c],d]]"},
+		{desc: "same place", from: 1, to: 1, exp: "r[a[b[c],d],e[f],g]"},
+		{desc: "out of range", from: 0, to: 3, exp: "r[a[b[c],d],e[f],g]", expErr: ErrEditTree},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			root := testTree()
+			err := root.MoveVariation(tc.from, tc.to)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, expected %v", err, tc.expErr)
+			}
+			if got := describe(root); got != tc.exp {
+				t.Errorf("got tree %s, expected %s", got, tc.exp)
+			}
+			checkNumbers(t, root)
+		})
+	}
+}
+
+func TestPromoteToMainline(t *testing.T) {
+	root := testTree()
+	find(root, "f").PromoteToMainline()
+	if got, exp := describe(root), "r[e[f],a[b[c],d],g]"; got != exp {
+		t.Errorf("got tree %s, expected %s", got, exp)
+	}
+	checkNumbers(t, root)
+
+	root = testTree()
+	find(root, "d").PromoteToMainline()
+	if got, exp := describe(root), "r[a[d,b[c]],e[f],g]"; got != exp {
+		t.Errorf("got tree %s, expected %s", got, exp)
+	}
+	checkNumbers(t, root)
+
+	var main []string
+	root.TraverseMainBranch(func(n *Node) {
+		main = append(main, n.Comment)
+	})
+	if got, exp := strings.Join(main, ""), "rad"; got != exp {
+		t.Errorf("got main branch %s, expected %s", got, exp)
+	}
+}
@@ -0,0 +1,97 @@
+package movetree
+
+// Emphasis is the degree of an evaluation: Normal, or Emphasized (such as a
+// very bad move). Zero means the evaluation isn't set.
+type Emphasis int
+
+const (
+	// Normal is a normal evaluation.
+	Normal Emphasis = 1
+	// Emphasized is an emphasized evaluation.
+	Emphasized Emphasis = 2
+)
+
+// PositionEval is an evaluation of the position at a node.
+type PositionEval string
+
+const (
+	// GoodForBlack means the position is good for Black (GB).
+	GoodForBlack PositionEval = "GB"
+	// GoodForWhite means the position is good for White (GW).
+	GoodForWhite PositionEval = "GW"
+	// Even means the position is even (DM).
+	Even PositionEval = "DM"
+	// Unclear means the position is unclear (UC).
+	Unclear PositionEval = "UC"
+)
+
+// MoveEval is an evaluation of the move at a node.
+type MoveEval string
+
+const (
+	// BadMove means the move is bad (BM).
+	BadMove MoveEval = "BM"
+	// Tesuji means the move is a tesuji, a good move (TE).
+	Tesuji MoveEval = "TE"
+	// Doubtful means the move is doubtful (DO).
+	Doubtful MoveEval = "DO"
+	// Interesting means the move is interesting (IT).
+	Interesting MoveEval = "IT"
+)
+
+// Evaluation contains the SGF node and move annotation properties, which
+// evaluate the position and the move at a node.
+type Evaluation struct {
+	// Position is the evaluation of the position, or empty if unset.
+	Position PositionEval
+
+	// PositionEmphasis is the emphasis of the position evaluation.
+	PositionEmphasis Emphasis
+
+	// Move is the evaluation of the move, or empty if unset.
+	Move MoveEval
+
+	// MoveEmphasis is the emphasis of the move evaluation. Only BadMove and
+	// Tesuji have an emphasis.
+	MoveEmphasis Emphasis
+
+	// Hotspot is the emphasis of the node as a hotspot (HO), an interesting
+	// node such as a game-deciding move, or zero if the node isn't a hotspot.
+	Hotspot Emphasis
+
+	// Value is the estimated score of the position (V), positive for Black
+	// and negative for White, or nil if unset.
+	Value *float64
+}
+
+// Filter returns the nodes in the tree starting at n (including n) for which
+// fn returns true, in the order of Traverse.
+func (n *Node) Filter(fn func(*Node) bool) []*Node {
+	var out []*Node
+	n.Traverse(func(nn *Node) {
+		if fn(nn) {
+			out = append(out, nn)
+		}
+	})
+	return out
+}
+
+// HasPositionEval returns a filter for nodes whose position has the given
+// evaluation.
+func HasPositionEval(eval PositionEval) func(*Node) bool {
+	return func(n *Node) bool {
+		return n.Evaluation.Position == eval
+	}
+}
+
+// HasMoveEval returns a filter for nodes whose move has the given evaluation.
+func HasMoveEval(eval MoveEval) func(*Node) bool {
+	return func(n *Node) bool {
+		return n.Evaluation.Move == eval
+	}
+}
+
+// IsHotspot is a filter for hotspot nodes.
+func IsHotspot(n *Node) bool {
+	return n.Evaluation.Hotspot != 0
+}
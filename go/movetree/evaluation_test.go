@@ -0,0 +1,44 @@
+package movetree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	root := testTree()
+	find(root, "b").Evaluation = Evaluation{Move: BadMove, MoveEmphasis: Normal, Hotspot: Emphasized}
+	find(root, "f").Evaluation = Evaluation{Move: BadMove, MoveEmphasis: Emphasized}
+	find(root, "g").Evaluation = Evaluation{Move: Tesuji, MoveEmphasis: Normal, Position: GoodForBlack, PositionEmphasis: Normal}
+
+	labels := func(nodes []*Node) []string {
+		var out []string
+		for _, n := range nodes {
+			out = append(out, n.Comment)
+		}
+		return out
+	}
+	testCases := []struct {
+		desc string
+		fn   func(*Node) bool
+		exp  []string
+	}{
+		{desc: "bad moves", fn: HasMoveEval(BadMove), exp: []string{"b", "f"}},
+		{desc: "tesuji", fn: HasMoveEval(Tesuji), exp: []string{"g"}},
+		{desc: "good for black", fn: HasPositionEval(GoodForBlack), exp: []string{"g"}},
+		{desc: "hotspots", fn: IsHotspot, exp: []string{"b"}},
+		{desc: "none", fn: HasMoveEval(Doubtful), exp: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := labels(root.Filter(tc.fn)); !reflect.DeepEqual(got, tc.exp) {
+				t.Errorf("got nodes %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+
+	// Filtering a subtree only returns nodes from the subtree.
+	if got, exp := labels(find(root, "e").Filter(HasMoveEval(BadMove))), []string{"f"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("got nodes %v in the subtree, expected %v", got, exp)
+	}
+}
@@ -0,0 +1,217 @@
+package movetree
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+)
+
+// GameInfo contains typed game properties that can exist only on the root.
+type GameInfo struct {
+	// Size of the board, where 19 = 19x19. Between 1 and 25 inclusive. A value of
+	// 0 should be taken to mean 'unspecified' and treated as 19x19. For
+	// rectangular boards, Size is the width of the board.
+	Size int
+
+	// Height of the board, for rectangular boards. Between 1 and 25 inclusive. A
+	// value of 0 means the board is square, with the height equal to Size.
+	Height int
+
+	// Komi are points added to the player with the white stones as compensation for playing second.
+	// Komi must have a decimal value of .0 or .5 (ex: 6.5)
+	Komi *float64
+
+	// Initial player turn. This is traditionally the player with the black stones
+	Player color.Color
+
+	// Handicap is the number of handicap stones given to Black. The stones
+	// themselves are stored as placements on the root. A value of 0 means no
+	// handicap.
+	Handicap int
+
+	// BlackPlayer and WhitePlayer are the names of the players.
+	BlackPlayer string
+	WhitePlayer string
+
+	// BlackRank and WhiteRank are the ranks of the players, such as 2k or 5d.
+	BlackRank string
+	WhiteRank string
+
+	// Dates are the dates when the game was played, in order.
+	Dates []Date
+
+	// Result is the result of the game, or nil if unspecified.
+	Result *Result
+
+	// Rules is the name of the ruleset used for the game, such as Japanese or
+	// Chinese.
+	Rules string
+
+	// MainTime is the main time for each player, or nil if unspecified.
+	MainTime *time.Duration
+
+	// Overtime describes the overtime (byo-yomi) method, such as "5x30
+	// byo-yomi".
+	Overtime string
+
+	// GameName is the name of the game.
+	GameName string
+
+	// Event is the name of the event (tournament) where the game was played.
+	Event string
+
+	// Round is the round of the event, such as "Final" or "3 (final)".
+	Round string
+
+	// Place is where the game was played.
+	Place string
+}
+
+// Dimensions returns the width and height of the board, treating an
+// unspecified size as 19x19.
+func (gi *GameInfo) Dimensions() (width, height int) {
+	width = gi.Size
+	if width == 0 {
+		width = 19
+	}
+	height = gi.Height
+	if height == 0 {
+		height = width
+	}
+	return width, height
+}
+
+// Clone returns a deep copy of the game info, which doesn't share the komi,
+// dates, result, or main time with gi.
+func (gi *GameInfo) Clone() *GameInfo {
+	out := *gi
+	if gi.Komi != nil {
+		komi := *gi.Komi
+		out.Komi = &komi
+	}
+	if gi.Dates != nil {
+		out.Dates = append([]Date(nil), gi.Dates...)
+	}
+	if gi.Result != nil {
+		res := *gi.Result
+		out.Result = &res
+	}
+	if gi.MainTime != nil {
+		mt := *gi.MainTime
+		out.MainTime = &mt
+	}
+	return &out
+}
+
+// BoardRules returns the board rules used for checking move legality under the
+// game's ruleset (RU), with the ko and suicide rules that KataGo uses for the
+// same rule-alias. Rulesets are matched case-insensitively, with underscores
+// treated as hyphens:
+//
+//   - tromp-taylor, goe, ing: positional superko, suicide allowed
+//   - chinese-ogs, chinese-kgs: positional superko
+//   - new-zealand, nz: situational superko, suicide allowed
+//   - aga, bga, french: situational superko
+//   - chinese, japanese, korean: simple ko
+//
+// Unknown or unspecified rulesets use simple ko.
+func (gi *GameInfo) BoardRules() board.Rules {
+	switch strings.ReplaceAll(strings.ToLower(gi.Rules), "_", "-") {
+	case "tromp-taylor", "goe", "ing":
+		return board.Rules{Ko: board.PositionalSuperko, AllowSuicide: true}
+	case "chinese-ogs", "chinese-kgs":
+		return board.Rules{Ko: board.PositionalSuperko}
+	case "new-zealand", "nz":
+		return board.Rules{Ko: board.SituationalSuperko, AllowSuicide: true}
+	case "aga", "bga", "french":
+		return board.Rules{Ko: board.SituationalSuperko}
+	}
+	return board.Rules{Ko: board.SimpleKo}
+}
+
+// Date is a calendar date that may be partial: a Day of 0 means only the year
+// and month are known, and a Month of 0 means only the year is known.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// String returns the date in the form YYYY-MM-DD, YYYY-MM, or YYYY, depending
+// on how much of it is known.
+func (d Date) String() string {
+	switch {
+	case d.Month == 0:
+		return fmt.Sprintf("%04d", d.Year)
+	case d.Day == 0:
+		return fmt.Sprintf("%04d-%02d", d.Year, int(d.Month))
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// ResultReason indicates how a game ended.
+type ResultReason string
+
+const (
+	// ReasonScore means the game was won by counting, by Result.Margin
+	// points.
+	ReasonScore ResultReason = "Score"
+	// ReasonResign means the game was won by resignation.
+	ReasonResign ResultReason = "Resign"
+	// ReasonTime means the game was won on time.
+	ReasonTime ResultReason = "Time"
+	// ReasonForfeit means the game was won by forfeit.
+	ReasonForfeit ResultReason = "Forfeit"
+	// ReasonUnspecified means the game was won, but it's not known how.
+	ReasonUnspecified ResultReason = ""
+
+	// ReasonDraw means the game was a draw (jigo), with no winner.
+	ReasonDraw ResultReason = "Draw"
+	// ReasonVoid means the game has no result, for example because it was
+	// suspended.
+	ReasonVoid ResultReason = "Void"
+	// ReasonUnknown means the result of the game is unknown.
+	ReasonUnknown ResultReason = "Unknown"
+)
+
+// Result is the result of a game.
+type Result struct {
+	// Winner is the player that won, or empty if the game was a draw, void,
+	// or has an unknown result.
+	Winner color.Color
+
+	// Reason is how the game ended.
+	Reason ResultReason
+
+	// Margin is the number of points the game was won by, for ReasonScore.
+	Margin float64
+}
+
+// String returns the result in the SGF form, such as B+R, W+3.5, or 0 for a
+// draw.
+func (r *Result) String() string {
+	switch r.Reason {
+	case ReasonDraw:
+		return "0"
+	case ReasonVoid:
+		return "Void"
+	case ReasonUnknown:
+		return "?"
+	}
+	s := string(r.Winner) + "+"
+	switch r.Reason {
+	case ReasonScore:
+		s += strconv.FormatFloat(r.Margin, 'f', -1, 64)
+	case ReasonResign:
+		s += "R"
+	case ReasonTime:
+		s += "T"
+	case ReasonForfeit:
+		s += "F"
+	}
+	return s
+}
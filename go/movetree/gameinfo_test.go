@@ -0,0 +1,71 @@
+package movetree
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+)
+
+func TestGameInfoClone(t *testing.T) {
+	komi := 6.5
+	mainTime := time.Hour
+	gi := &GameInfo{
+		Size:     19,
+		Komi:     &komi,
+		Dates:    []Date{{Year: 2020, Month: time.August, Day: 5}},
+		Result:   &Result{Winner: color.White, Reason: ReasonResign},
+		MainTime: &mainTime,
+		Rules:    "Japanese",
+	}
+	cl := gi.Clone()
+	if !reflect.DeepEqual(cl, gi) {
+		t.Fatalf("got clone %+v, expected %+v", cl, gi)
+	}
+
+	*cl.Komi = 7.5
+	cl.Dates[0].Day = 6
+	cl.Result.Winner = color.Black
+	*cl.MainTime = time.Minute
+	if *gi.Komi != 6.5 || gi.Dates[0].Day != 5 || gi.Result.Winner != color.White || *gi.MainTime != time.Hour {
+		t.Errorf("changing the clone changed the original game info to %+v", gi)
+	}
+}
+
+func TestGameInfo_BoardRules(t *testing.T) {
+	positional := board.Rules{Ko: board.PositionalSuperko}
+	positionalSuicide := board.Rules{Ko: board.PositionalSuperko, AllowSuicide: true}
+	situational := board.Rules{Ko: board.SituationalSuperko}
+	situationalSuicide := board.Rules{Ko: board.SituationalSuperko, AllowSuicide: true}
+	simple := board.Rules{Ko: board.SimpleKo}
+
+	testCases := []struct {
+		rules string
+		exp   board.Rules
+	}{
+		{rules: "tromp-taylor", exp: positionalSuicide},
+		{rules: "Tromp_Taylor", exp: positionalSuicide},
+		{rules: "goe", exp: positionalSuicide},
+		{rules: "ing", exp: positionalSuicide},
+		{rules: "chinese-ogs", exp: positional},
+		{rules: "chinese_kgs", exp: positional},
+		{rules: "new-zealand", exp: situationalSuicide},
+		{rules: "NZ", exp: situationalSuicide},
+		{rules: "AGA", exp: situational},
+		{rules: "bga", exp: situational},
+		{rules: "french", exp: situational},
+		{rules: "Chinese", exp: simple},
+		{rules: "Japanese", exp: simple},
+		{rules: "korean", exp: simple},
+		{rules: "", exp: simple},
+		{rules: "zork", exp: simple},
+	}
+	for _, tc := range testCases {
+		gi := &GameInfo{Rules: tc.rules}
+		if got := gi.BoardRules(); got != tc.exp {
+			t.Errorf("BoardRules() for %q = %v, expected %v", tc.rules, got, tc.exp)
+		}
+	}
+}
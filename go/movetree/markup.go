@@ -0,0 +1,60 @@
+package movetree
+
+import "github.com/otrego/clamshell/go/point"
+
+// MarkType is a shape drawn on a point.
+type MarkType string
+
+const (
+	// Triangle is a triangle (TR).
+	Triangle MarkType = "TR"
+	// Square is a square (SQ).
+	Square MarkType = "SQ"
+	// Circle is a circle (CR).
+	Circle MarkType = "CR"
+	// XMark is an X (MA).
+	XMark MarkType = "MA"
+)
+
+// Mark is a shape drawn on a point.
+type Mark struct {
+	Point *point.Point
+	Type  MarkType
+}
+
+// Label is text drawn on a point.
+type Label struct {
+	Point *point.Point
+	Text  string
+}
+
+// Line is a line or an arrow between two points.
+type Line struct {
+	From *point.Point
+	To   *point.Point
+}
+
+// Markup contains the markup drawn on the board at a node. Other than
+// Dimmed, markup only applies to the node it's on.
+type Markup struct {
+	// Marks are the shapes drawn on points.
+	Marks []*Mark
+
+	// Labels are the text labels drawn on points.
+	Labels []*Label
+
+	// Arrows are the arrows drawn between points, pointing to To.
+	Arrows []*Line
+
+	// Lines are the lines drawn between points.
+	Lines []*Line
+
+	// Dimmed are the points that are dimmed (grayed out). Unlike the other
+	// markup, dimmed points carry over to the following nodes until a node sets
+	// new dimmed points. A nil slice means the dimmed points are unchanged,
+	// and an empty (non-nil) slice clears them.
+	Dimmed []*point.Point
+
+	// Selected are the points that are selected.
+	Selected []*point.Point
+}
@@ -0,0 +1,363 @@
+package movetree
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// ErrMerge indicates that movetrees can't be merged.
+var ErrMerge = errors.New("error merging movetrees")
+
+// MergeOptions contains options for merging movetrees.
+type MergeOptions struct {
+	// Transpositions enables transposition detection. When a new node
+	// reaches a board position (stones, player to move, and ko) that's
+	// already in the merged tree, the node is added, but its continuation is
+	// merged into the existing position instead.
+	Transpositions bool
+}
+
+// Transposition records a node whose position was reached by a different
+// move order elsewhere in the merged tree.
+type Transposition struct {
+	// Path is the path to the node that transposes.
+	Path Path
+
+	// Target is the path to the node where the position was first reached.
+	// The moves that followed the transposing node are merged under it.
+	Target Path
+}
+
+// MergeResult contains the result of merging movetrees.
+type MergeResult struct {
+	// Tree is the merged movetree.
+	Tree *MoveTree
+
+	// Transpositions contains the transpositions found, if transposition
+	// detection was enabled.
+	Transpositions []*Transposition
+}
+
+// Merge merges movetrees that share a common setup (board size and root
+// placements) into a new movetree, without modifying them. Identical move
+// sequences are unified into a single line, with branches where they
+// diverge. Nodes are identical when they have the same move and the same
+// placements.
+//
+// The root's game info comes from the first tree. When nodes are unified,
+// their comments are combined (skipping duplicates), as are their markup and
+// SGF properties. For evaluations, and for each annotation key, the first
+// value found is kept.
+func Merge(trees []*MoveTree, opts *MergeOptions) (*MergeResult, error) {
+	if len(trees) == 0 {
+		return nil, fmt.Errorf("%w: no trees to merge", ErrMerge)
+	}
+	if opts == nil {
+		opts = &MergeOptions{}
+	}
+
+	first := trees[0].Root
+	root := copyNode(first)
+	if first.GameInfo != nil {
+		root.GameInfo = first.GameInfo.Clone()
+	}
+	m := &merger{
+		opts:      opts,
+		result:    &MergeResult{Tree: &MoveTree{Root: root}},
+		positions: make(map[uint64]*mergedPosition),
+	}
+	width, height := dimensions(first)
+
+	for i, mt := range trees {
+		if w, h := dimensions(mt.Root); w != width || h != height {
+			return nil, fmt.Errorf("%w: tree %d is %dx%d, but tree 0 is %dx%d", ErrMerge, i, w, h, width, height)
+		}
+		if !sameNode(mt.Root, first) {
+			return nil, fmt.Errorf("%w: tree %d has a different setup from tree 0", ErrMerge, i)
+		}
+		if i > 0 {
+			mergeData(root, mt.Root)
+		}
+
+		b := board.NewRect(width, height)
+		b.SetRules(boardRules(mt.Root))
+		if err := applyNode(b, mt.Root); err != nil {
+			return nil, fmt.Errorf("%w: tree %d: %v", ErrMerge, i, err)
+		}
+		if opts.Transpositions {
+			if _, ok := m.positions[b.Hash()]; !ok {
+				m.positions[b.Hash()] = &mergedPosition{node: root, path: Path{}}
+			}
+		}
+		if err := m.mergeChildren(mt.Root, root, Path{}, b); err != nil {
+			return nil, fmt.Errorf("%w: tree %d: %v", ErrMerge, i, err)
+		}
+	}
+	return m.result, nil
+}
+
+// merger contains the state for merging movetrees.
+type merger struct {
+	opts   *MergeOptions
+	result *MergeResult
+
+	// positions maps the board hashes in the merged tree to the node where
+	// they first appear.
+	positions map[uint64]*mergedPosition
+}
+
+// mergedPosition is a node in the merged tree and its path.
+type mergedPosition struct {
+	node *Node
+	path Path
+}
+
+// mergeChildren merges the children of from (in a source tree) into to (in
+// the merged tree), where b is the board position at from.
+func (m *merger) mergeChildren(from, to *Node, toPath Path, b *board.Board) error {
+	for _, fc := range from.Children {
+		nb := b.Clone()
+		if err := applyNode(nb, fc); err != nil {
+			return err
+		}
+
+		var tc *Node
+		for _, c := range to.Children {
+			if sameNode(c, fc) {
+				tc = c
+				break
+			}
+		}
+		if tc != nil {
+			mergeData(tc, fc)
+			if err := m.mergeChildren(fc, tc, append(toPath.Clone(), tc.varNum), nb); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tc = copyNode(fc)
+		to.AddChild(tc)
+		path := append(toPath.Clone(), tc.varNum)
+		if m.opts.Transpositions {
+			if target, ok := m.positions[nb.Hash()]; ok {
+				m.result.Transpositions = append(m.result.Transpositions, &Transposition{
+					Path:   path,
+					Target: target.path.Clone(),
+				})
+				if err := m.mergeChildren(fc, target.node, target.path, nb); err != nil {
+					return err
+				}
+				continue
+			}
+			m.positions[nb.Hash()] = &mergedPosition{node: tc, path: path}
+		}
+		if err := m.mergeChildren(fc, tc, path, nb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyNode places the placements and move of a node on a board.
+func applyNode(b *board.Board, n *Node) error {
+	if len(n.Placements) > 0 {
+		if err := b.SetPlacements(n.Placements); err != nil {
+			return err
+		}
+	}
+	if n.Move != nil && n.Move.Color() != color.Empty {
+		if _, err := b.PlaceStone(n.Move); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyNode copies the data of a node, but not its game info or its links to
+// other nodes.
+func copyNode(n *Node) *Node {
+	nn := NewNode()
+	nn.Move = n.Move
+	nn.Placements = append(move.List(nil), n.Placements...)
+	nn.Comment = n.Comment
+	mergeMarkup(&nn.Markup, &n.Markup)
+	nn.Evaluation = n.Evaluation
+	for k, v := range n.SGFProperties {
+		nn.SGFProperties[k] = append([]string(nil), v...)
+	}
+	nn.CopyAnnotations(n)
+	return nn
+}
+
+// mergeData merges the comment, markup, evaluation, SGF properties, and
+// annotations of src into dst.
+func mergeData(dst, src *Node) {
+	switch {
+	case src.Comment == "" || strings.Contains(dst.Comment, src.Comment):
+	case dst.Comment == "":
+		dst.Comment = src.Comment
+	default:
+		dst.Comment += "\n\n" + src.Comment
+	}
+
+	mergeMarkup(&dst.Markup, &src.Markup)
+	mergeEvaluation(&dst.Evaluation, &src.Evaluation)
+
+	for k, vals := range src.SGFProperties {
+		for _, v := range vals {
+			if !containsString(dst.SGFProperties[k], v) {
+				dst.SGFProperties[k] = append(dst.SGFProperties[k], v)
+			}
+		}
+	}
+
+	for name, v := range src.annotations {
+		if _, ok := dst.annotations[name]; !ok {
+			if dst.annotations == nil {
+				dst.annotations = make(map[string]interface{})
+			}
+			dst.annotations[name] = v
+		}
+	}
+}
+
+// mergeMarkup adds the markup from src to dst, skipping markup that dst
+// already has.
+func mergeMarkup(dst, src *Markup) {
+	for _, m := range src.Marks {
+		found := false
+		for _, d := range dst.Marks {
+			found = found || (d.Type == m.Type && d.Point.Equal(m.Point))
+		}
+		if !found {
+			dst.Marks = append(dst.Marks, m)
+		}
+	}
+	for _, l := range src.Labels {
+		found := false
+		for _, d := range dst.Labels {
+			found = found || (d.Text == l.Text && d.Point.Equal(l.Point))
+		}
+		if !found {
+			dst.Labels = append(dst.Labels, l)
+		}
+	}
+	dst.Arrows = mergeLines(dst.Arrows, src.Arrows)
+	dst.Lines = mergeLines(dst.Lines, src.Lines)
+	if dst.Dimmed == nil && src.Dimmed != nil {
+		dst.Dimmed = []*point.Point{}
+	}
+	dst.Dimmed = mergePoints(dst.Dimmed, src.Dimmed)
+	dst.Selected = mergePoints(dst.Selected, src.Selected)
+}
+
+// mergeEvaluation adds the evaluations from src that aren't set in dst.
+func mergeEvaluation(dst, src *Evaluation) {
+	if dst.Position == "" {
+		dst.Position, dst.PositionEmphasis = src.Position, src.PositionEmphasis
+	}
+	if dst.Move == "" {
+		dst.Move, dst.MoveEmphasis = src.Move, src.MoveEmphasis
+	}
+	if dst.Hotspot == 0 {
+		dst.Hotspot = src.Hotspot
+	}
+	if dst.Value == nil {
+		dst.Value = src.Value
+	}
+}
+
+func mergeLines(dst, src []*Line) []*Line {
+	for _, l := range src {
+		found := false
+		for _, d := range dst {
+			found = found || (d.From.Equal(l.From) && d.To.Equal(l.To))
+		}
+		if !found {
+			dst = append(dst, l)
+		}
+	}
+	return dst
+}
+
+func mergePoints(dst, src []*point.Point) []*point.Point {
+	for _, pt := range src {
+		found := false
+		for _, d := range dst {
+			found = found || d.Equal(pt)
+		}
+		if !found {
+			dst = append(dst, pt)
+		}
+	}
+	return dst
+}
+
+// sameNode indicates whether two nodes have the same move and the same
+// placements (in any order).
+func sameNode(a, b *Node) bool {
+	if !sameMove(a.Move, b.Move) || len(a.Placements) != len(b.Placements) {
+		return false
+	}
+	for _, pa := range a.Placements {
+		found := false
+		for _, pb := range b.Placements {
+			if sameMove(pa, pb) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// sameMove indicates whether two moves (which may be nil, or passes) are the
+// same.
+func sameMove(a, b *move.Move) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Color() != b.Color() {
+		return false
+	}
+	if a.Point() == nil || b.Point() == nil {
+		return a.Point() == nil && b.Point() == nil
+	}
+	return a.Point().Equal(b.Point())
+}
+
+// dimensions returns the board dimensions from a root's game info.
+func dimensions(root *Node) (int, int) {
+	if root.GameInfo == nil {
+		return (&GameInfo{}).Dimensions()
+	}
+	return root.GameInfo.Dimensions()
+}
+
+// boardRules returns the board rules for the game, from the ruleset in the root's
+// game info.
+func boardRules(root *Node) board.Rules {
+	if root.GameInfo == nil {
+		return (&GameInfo{}).BoardRules()
+	}
+	return root.GameInfo.BoardRules()
+}
+
+func containsString(vals []string, s string) bool {
+	for _, v := range vals {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package movetree_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/sgf"
+)
+
+func parseAll(t *testing.T, sgfs ...string) []*movetree.MoveTree {
+	t.Helper()
+	var out []*movetree.MoveTree
+	for _, s := range sgfs {
+		mt, err := sgf.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, mt)
+	}
+	return out
+}
+
+// moveAt returns the SGF point of the move at the path, or "" if the path
+// doesn't lead to a node with a move.
+func moveAt(t *testing.T, mt *movetree.MoveTree, p movetree.Path) string {
+	t.Helper()
+	n := mt.Root
+	for _, v := range p {
+		if n = n.Next(v); n == nil {
+			return ""
+		}
+	}
+	if n.Move == nil {
+		return ""
+	}
+	pt, err := n.Move.Point().ToSGF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pt
+}
+
+func TestMerge(t *testing.T) {
+	trees := parseAll(t,
+		"(;GM[1]SZ[9];B[ee]C[first]TR[ee];W[cc];B[gg])",
+		"(;GM[1]SZ[9];B[ee]C[second]TR[ee]LB[cc:A];W[cc];B[cg]C[new])",
+		"(;GM[1]SZ[9];B[ee]C[first](;W[gc])(;W[cc]))")
+
+	res, err := movetree.Merge(trees, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mt := res.Tree
+	testCases := []struct {
+		path movetree.Path
+		exp  string
+	}{
+		{path: movetree.Path{0}, exp: "ee"},
+		{path: movetree.Path{0, 0}, exp: "cc"},
+		{path: movetree.Path{0, 0, 0}, exp: "gg"},
+		{path: movetree.Path{0, 0, 1}, exp: "cg"},
+		{path: movetree.Path{0, 1}, exp: "gc"},
+		{path: movetree.Path{0, 2}, exp: ""},
+		{path: movetree.Path{1}, exp: ""},
+	}
+	for _, tc := range testCases {
+		if got := moveAt(t, mt, tc.path); got != tc.exp {
+			t.Errorf("got move %q at path %v, expected %q", got, tc.path, tc.exp)
+		}
+	}
+
+	if got, exp := mt.Root.Children[0].Comment, "first\n\nsecond"; got != exp {
+		t.Errorf("got comment %q, expected %q", got, exp)
+	}
+	if m := mt.Root.Children[0].Markup; len(m.Marks) != 1 || len(m.Labels) != 1 {
+		t.Errorf("got marks %v and labels %v, expected one of each", m.Marks, m.Labels)
+	}
+	if got := mt.Root.Children[0].Children[0].Children[1].MoveNum(); got != 3 {
+		t.Errorf("got move number %d, expected 3", got)
+	}
+	if mt.Root.GameInfo.Size != 9 {
+		t.Errorf("got size %d, expected 9", mt.Root.GameInfo.Size)
+	}
+	if len(res.Transpositions) != 0 {
+		t.Errorf("got transpositions %v without detection, expected none", res.Transpositions)
+	}
+
+	// The inputs are unchanged.
+	if n := len(trees[0].Root.Children[0].Children[0].Children[0].Children); n != 0 {
+		t.Errorf("got %d children in an input tree, expected it to be unchanged", n)
+	}
+	if c := trees[0].Root.Children[0].Comment; c != "first" {
+		t.Errorf("got comment %q in an input tree, expected it to be unchanged", c)
+	}
+	if l := trees[0].Root.Children[0].Markup.Labels; len(l) != 0 {
+		t.Errorf("got labels %v in an input tree, expected it to be unchanged", l)
+	}
+}
+
+func TestMerge_Transpositions(t *testing.T) {
+	trees := parseAll(t,
+		"(;GM[1]SZ[9];B[aa];W[bb];B[cc])",
+		"(;GM[1]SZ[9];B[cc];W[bb];B[aa];W[dd])")
+
+	res, err := movetree.Merge(trees, &movetree.MergeOptions{Transpositions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []*movetree.Transposition{
+		{Path: movetree.Path{1, 0, 0}, Target: movetree.Path{0, 0, 0}},
+	}
+	if !reflect.DeepEqual(res.Transpositions, exp) {
+		t.Errorf("got transpositions %v, expected %v", res.Transpositions, exp)
+	}
+	if got := moveAt(t, res.Tree, movetree.Path{0, 0, 0, 0}); got != "dd" {
+		t.Errorf("got move %q after the transposition target, expected dd", got)
+	}
+	if got := moveAt(t, res.Tree, movetree.Path{1, 0, 0}); got != "aa" {
+		t.Errorf("got move %q at the transposing node, expected aa", got)
+	}
+	if n := len(res.Tree.Root.Children[1].Children[0].Children[0].Children); n != 0 {
+		t.Errorf("got %d children of the transposing node, expected 0", n)
+	}
+}
+
+func TestMerge_Errors(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		trees []*movetree.MoveTree
+	}{
+		{
+			desc: "no trees",
+		},
+		{
+			desc:  "different sizes",
+			trees: parseAll(t, "(;GM[1]SZ[9];B[aa])", "(;GM[1]SZ[13];B[aa])"),
+		},
+		{
+			desc:  "different setup",
+			trees: parseAll(t, "(;GM[1]SZ[9]AB[cc];B[aa])", "(;GM[1]SZ[9]AB[dd];B[aa])"),
+		},
+		{
+			desc:  "illegal move",
+			trees: parseAll(t, "(;GM[1]SZ[9];B[aa];W[aa])"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if _, err := movetree.Merge(tc.trees, nil); !errors.Is(err, movetree.ErrMerge) {
+				t.Errorf("got error %v, expected %v", err, movetree.ErrMerge)
+			}
+		})
+	}
+}
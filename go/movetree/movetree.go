@@ -2,6 +2,8 @@
 // problem. A movetree can be serialized to / deserialized from an SGF.
 package movetree
 
+import "fmt"
+
 // MoveTree contains the game tree information for a go game.
 type MoveTree struct {
 	Root *Node
@@ -21,3 +23,53 @@ func New() *MoveTree {
 	g.Root.SGFProperties["CA"] = []string{"UTF-8"} // CA[UTF-8]=UTF-8 encoding
 	return g
 }
+
+// Clone returns a deep copy of the movetree, so that the copy can be edited
+// without changing mt. The values of the annotations are shared, as with
+// CopyAnnotations.
+func (mt *MoveTree) Clone() *MoveTree {
+	if mt.Root == nil {
+		return &MoveTree{}
+	}
+	root := copyNode(mt.Root)
+	if mt.Root.GameInfo != nil {
+		root.GameInfo = mt.Root.GameInfo.Clone()
+	}
+
+	type pair struct{ from, to *Node }
+	queue := []pair{{mt.Root, root}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, c := range cur.from.Children {
+			nc := copyNode(c)
+			cur.to.AddChild(nc)
+			queue = append(queue, pair{c, nc})
+		}
+	}
+	return &MoveTree{Root: root}
+}
+
+// MainlinePath returns the treepath to the node at the given move number along
+// the main line (the first variation of each node), returning an error if the
+// main line is shorter than that.
+func (mt *MoveTree) MainlinePath(moveNum int) (Path, error) {
+	if moveNum < 0 {
+		return nil, fmt.Errorf("%w: negative move number %d", ErrApplyTreepath, moveNum)
+	}
+	p := make(Path, moveNum)
+	if err := p.Validate(mt.Root); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// MainlineNode returns the node at the given move number along the main line,
+// returning an error if the main line is shorter than that.
+func (mt *MoveTree) MainlineNode(moveNum int) (*Node, error) {
+	p, err := mt.MainlinePath(moveNum)
+	if err != nil {
+		return nil, err
+	}
+	return p.Find(mt.Root)
+}
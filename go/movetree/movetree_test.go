@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
 )
 
 func TestDefaults(t *testing.T) {
@@ -29,3 +32,37 @@ func TestDefaults(t *testing.T) {
 		t.Errorf("g.Root.SGFProperties[CA] was %v; expected %v", got, expCA)
 	}
 }
+
+func TestClone(t *testing.T) {
+	g := New()
+	komi := 6.5
+	g.Root.GameInfo.Komi = &komi
+	b := NewNode()
+	b.Move = move.New(color.Black, point.New(3, 3))
+	b.Comment = "first"
+	g.Root.AddChild(b)
+	w := NewNode()
+	w.Move = move.New(color.White, point.New(15, 15))
+	w.Markup.Marks = []*Mark{{Point: point.New(3, 3), Type: Triangle}}
+	b.AddChild(w)
+	b.AddChild(NewNode())
+
+	c := g.Clone()
+	if c.Root == g.Root || c.Root.GameInfo == g.Root.GameInfo || c.Root.GameInfo.Komi == g.Root.GameInfo.Komi {
+		t.Fatalf("expected the clone not to share the root or its game info")
+	}
+	cb := c.Root.Next(0)
+	if cb == b || cb.Parent != c.Root || cb.Comment != "first" || !cb.Move.Point().Equal(b.Move.Point()) {
+		t.Errorf("got first node %+v, expected a copy of %+v", cb, b)
+	}
+	if len(cb.Children) != 2 || cb.Next(1).VarNum() != 1 || cb.Next(0).MoveNum() != 2 {
+		t.Errorf("got children %v, expected two variations", cb.Children)
+	}
+
+	cw := cb.Next(0)
+	cw.Markup.Marks[0] = &Mark{Point: point.New(0, 0), Type: Circle}
+	cb.Comment = "changed"
+	if w.Markup.Marks[0].Type != Triangle || b.Comment != "first" {
+		t.Errorf("expected editing the clone to leave the original unchanged")
+	}
+}
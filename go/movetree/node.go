@@ -1,24 +1,9 @@
 package movetree
 
 import (
-	"github.com/otrego/clamshell/go/color"
 	"github.com/otrego/clamshell/go/move"
 )
 
-// GameInfo contains typed game properties that can exist only on the root.
-type GameInfo struct {
-	// Size of the board, where 19 = 19x19. Between 1 and 25 inclusive. A value of
-	// 0 should be taken to mean 'unspecified' and treated as 19x19.
-	Size int
-
-	// Komi are points added to the player with the white stones as compensation for playing second.
-	// Komi must have a decimal value of .0 or .5 (ex: 6.5)
-	Komi *float64
-
-	// Initial player turn. This is traditionally the player with the black stones
-	Player color.Color
-}
-
 // Node contains Properties, Children nodes, and Parent node.
 type Node struct {
 	// moveNum is the move and indicates the current move number or depth for this
@@ -40,12 +25,21 @@ type Node struct {
 	Move *move.Move
 
 	// Placements are stones that are used for setup, but actual moves. For
-	// example, handicap stones will be in in placements.
+	// example, handicap stones will be in in placements. Placements with an
+	// empty color clear the point (AE).
 	Placements move.List
 
 	// Comment is the comment for the current node.
 	Comment string
 
+	// Markup is the markup drawn on the board at this node, such as marks and
+	// labels.
+	Markup Markup
+
+	// Evaluation contains the evaluations of the position and move at this
+	// node, such as a bad move or a position that's good for Black.
+	Evaluation Evaluation
+
 	// GameInfo contains properties only found on the root. Should be nil on
 	// non-root nodes.
 	GameInfo *GameInfo
@@ -53,9 +47,9 @@ type Node struct {
 	// SGFProperties contain all the raw/unprocessed properties
 	SGFProperties map[string][]string
 
-	// analysisData contains arbitrary/untyped AnalysisData that is attached to
-	// this node.
-	analysisData interface{}
+	// annotations contains typed data attached to this node, such as engine
+	// analysis, keyed by the name of its Key.
+	annotations map[string]interface{}
 }
 
 // NewNode creates a Node.
@@ -65,11 +59,12 @@ func NewNode() *Node {
 	}
 }
 
-// AddChild adds a child node.
+// AddChild adds a child node as the last variation. The child's parent is
+// set, and the move and variation numbers of its subtree are updated.
 func (n *Node) AddChild(nn *Node) {
-	nn.moveNum = n.moveNum + 1
-	nn.varNum = len(n.Children)
+	nn.Parent = n
 	n.Children = append(n.Children, nn)
+	n.renumberChild(len(n.Children) - 1)
 }
 
 // Next gets the next node, given the variation number, returning nil if no node
@@ -92,15 +87,17 @@ func (n *Node) VarNum() int {
 	return n.varNum
 }
 
-// SetAnalysisData sets the analysis data.
-func (n *Node) SetAnalysisData(an interface{}) {
-	n.analysisData = an
-}
-
-// AnalysisData gets the attached analysis data, returning nil if the
-// analysisData is empty.
-func (n *Node) AnalysisData() interface{} {
-	return n.analysisData
+// Path returns the treepath from the root of the node's tree to the node,
+// found by following the parent pointers.
+func (n *Node) Path() Path {
+	p := Path{}
+	for cur := n; cur.Parent != nil; cur = cur.Parent {
+		p = append(p, cur.varNum)
+	}
+	for i, j := 0, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+	return p
 }
 
 // Traverse Traverses the tree using BFS.
@@ -16,6 +16,8 @@ var ErrParseTreepath = errors.New("error parsing treepath")
 
 var ErrApplyTreepath = errors.New("error applying treepath")
 
+var ErrRelativeTreepath = errors.New("error finding relative treepath")
+
 // A Path is a list of variations that says how to travel through a tree of
 // moves. And has two forms a list version and a string version. First, the
 // list-version:
@@ -178,8 +180,62 @@ func (tp Path) Apply(n *Node) *Node {
 	return curNode
 }
 
+// Find applies a treepath to a node, returning the node at the end of the
+// treepath. Unlike Apply, it returns an error if a variation in the treepath
+// doesn't exist, rather than stopping early.
+func (tp Path) Find(n *Node) (*Node, error) {
+	curNode := n
+	for i, v := range tp {
+		if v < 0 || v >= len(curNode.Children) {
+			return nil, fmt.Errorf("%w: no variation %d at traversed path %v", ErrApplyTreepath, v, tp[:i])
+		}
+		curNode = curNode.Children[v]
+	}
+	return curNode, nil
+}
+
+// Validate checks that the treepath exists in the tree starting from a node.
+func (tp Path) Validate(n *Node) error {
+	_, err := tp.Find(n)
+	return err
+}
+
+// Append returns a new treepath made of the variations of tp followed by the
+// variations of other.
+func (tp Path) Append(other Path) Path {
+	out := make(Path, 0, len(tp)+len(other))
+	out = append(out, tp...)
+	return append(out, other...)
+}
+
+// HasPrefix indicates whether the treepath starts with the variations of
+// prefix, meaning the node at prefix is an ancestor of (or the same as) the
+// node at tp.
+func (tp Path) HasPrefix(prefix Path) bool {
+	if len(prefix) > len(tp) {
+		return false
+	}
+	for i := range prefix {
+		if tp[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Relative returns the treepath from the node at base to the node at tp, so
+// that base.Append(rel) is equal to tp. Both treepaths must start from the same
+// node, and base must be a prefix of tp.
+func (tp Path) Relative(base Path) (Path, error) {
+	if !tp.HasPrefix(base) {
+		return nil, fmt.Errorf("%w: %v is not a prefix of %v", ErrRelativeTreepath, base, tp)
+	}
+	return tp[len(base):].Clone(), nil
+}
+
 // ApplyToBoard applies a treepath to a Go-Board, returning the captured stones,
-// or an error if the application was unsuccessful.
+// or an error if the application was unsuccessful. It's an error for the
+// treepath to refer to a variation that doesn't exist.
 //
 // A board copy, and the relevant captures are returned
 func (tp Path) ApplyToBoard(n *Node, b *board.Board) (*board.Board, move.List, error) {
@@ -210,11 +266,10 @@ func (tp Path) ApplyToBoard(n *Node, b *board.Board) (*board.Board, move.List, e
 		}
 		nextVar := tp[i]
 
-		if nextVar < len(n.Children) {
-			n = n.Children[nextVar]
-		} else {
-			n = nil
+		if nextVar < 0 || nextVar >= len(n.Children) {
+			return nil, nil, fmt.Errorf("%w: no variation %d at traversed path %v", ErrApplyTreepath, nextVar, tp[:i])
 		}
+		n = n.Children[nextVar]
 	}
 	captures.Sort()
 	return b, captures, nil
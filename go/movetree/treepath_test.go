@@ -324,6 +324,35 @@ func TestApplyToBoard(t *testing.T) {
 				move.New(color.White, point.New(0, 1)),
 			},
 		},
+		{
+			desc: "apply with compressed placements & cleared points",
+			sgf:  "(;GM[1]AB[aa:bb];W[dd];AE[aa:ba]AW[cc];B[ee])",
+			b:    makeBoard(move.List{}),
+			tp:   "0x3",
+			expBoard: makeBoard(move.List{
+				move.New(color.Black, point.New(0, 1)),
+				move.New(color.Black, point.New(1, 1)),
+
+				move.New(color.White, point.New(3, 3)),
+				move.New(color.White, point.New(2, 2)),
+
+				move.New(color.Black, point.New(4, 4)),
+			}),
+		},
+		{
+			desc:   "missing variation",
+			sgf:    "(;GM[1];B[aa];W[ab])",
+			b:      makeBoard(move.List{}),
+			tp:     "0-1",
+			expErr: movetree.ErrApplyTreepath,
+		},
+		{
+			desc:   "past the end",
+			sgf:    "(;GM[1];B[aa];W[ab])",
+			b:      makeBoard(move.List{}),
+			tp:     "0x3",
+			expErr: movetree.ErrApplyTreepath,
+		},
 	}
 
 	for _, tci := range testCases {
@@ -359,3 +388,129 @@ func TestApplyToBoard(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyToBoard_Undo(t *testing.T) {
+	mt, err := sgf.Parse("(;GM[1]SZ[9];B[aa];W[bb];B[cc])")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := board.New(9)
+	start.EnableHistory()
+	b, _, err := movetree.Path{0, 0, 0}.ApplyToBoard(mt.Root, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, exp := range []string{"cc", "bb", "aa"} {
+		m, err := b.Undo()
+		if err != nil {
+			t.Fatalf("undoing %s: %v", exp, err)
+		}
+		if got, _ := m.Point().ToSGF(); got != exp {
+			t.Errorf("got undone move %s, expected %s", got, exp)
+		}
+	}
+	if b.CanUndo() {
+		t.Errorf("got CanUndo()=true after undoing back to the root")
+	}
+	if !reflect.DeepEqual(b.FullBoardState(), board.New(9).FullBoardState()) {
+		t.Errorf("got board\n%v\nafter undoing back to the root, expected an empty board", b)
+	}
+}
+
+func TestFind(t *testing.T) {
+	g, err := sgf.Parse("(;GM[1];B[aa]C[a](;W[ab]C[b];B[ac]C[c])(;W[bb]C[d]))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		desc    string
+		path    movetree.Path
+		expNode string
+		expErr  error
+	}{
+		{desc: "root", path: movetree.Path{}},
+		{desc: "main line", path: movetree.Path{0, 0, 0}, expNode: "c"},
+		{desc: "variation", path: movetree.Path{0, 1}, expNode: "d"},
+		{desc: "missing variation", path: movetree.Path{0, 2}, expErr: movetree.ErrApplyTreepath},
+		{desc: "past the end", path: movetree.Path{0, 1, 0}, expErr: movetree.ErrApplyTreepath},
+		{desc: "negative", path: movetree.Path{-1}, expErr: movetree.ErrApplyTreepath},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			n, err := tc.path.Find(g.Root)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, expected %v", err, tc.expErr)
+			}
+			if err := tc.path.Validate(g.Root); !errors.Is(err, tc.expErr) {
+				t.Errorf("got validation error %v, expected %v", err, tc.expErr)
+			}
+			if err != nil {
+				return
+			}
+			if n.Comment != tc.expNode {
+				t.Errorf("got node %q, expected %q", n.Comment, tc.expNode)
+			}
+			if got := n.Path(); !reflect.DeepEqual(got, tc.path) {
+				t.Errorf("got n.Path()=%v, expected %v", got, tc.path)
+			}
+		})
+	}
+}
+
+func TestAppendAndRelative(t *testing.T) {
+	base := movetree.Path{0, 1}
+	full := base.Append(movetree.Path{2, 0})
+	if exp := (movetree.Path{0, 1, 2, 0}); !reflect.DeepEqual(full, exp) {
+		t.Errorf("got appended path %v, expected %v", full, exp)
+	}
+	if exp := (movetree.Path{0, 1}); !reflect.DeepEqual(base, exp) {
+		t.Errorf("got base path %v after appending, expected it to be unchanged", base)
+	}
+
+	rel, err := full.Relative(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (movetree.Path{2, 0}); !reflect.DeepEqual(rel, exp) {
+		t.Errorf("got relative path %v, expected %v", rel, exp)
+	}
+	if rel, err := full.Relative(movetree.Path{}); err != nil || !reflect.DeepEqual(rel, full) {
+		t.Errorf("got relative path %v, %v from the root, expected %v", rel, err, full)
+	}
+	if _, err := full.Relative(movetree.Path{0, 0}); !errors.Is(err, movetree.ErrRelativeTreepath) {
+		t.Errorf("got error %v for a non-prefix, expected %v", err, movetree.ErrRelativeTreepath)
+	}
+	if _, err := base.Relative(full); !errors.Is(err, movetree.ErrRelativeTreepath) {
+		t.Errorf("got error %v for a longer base, expected %v", err, movetree.ErrRelativeTreepath)
+	}
+}
+
+func TestMainline(t *testing.T) {
+	g, err := sgf.Parse("(;GM[1];B[aa](;W[ab];B[ac]C[c])(;W[bb]))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := g.MainlinePath(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (movetree.Path{0, 0, 0}); !reflect.DeepEqual(p, exp) {
+		t.Errorf("got path %v, expected %v", p, exp)
+	}
+	n, err := g.MainlineNode(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Comment != "c" || n.MoveNum() != 3 {
+		t.Errorf("got node %q at move %d, expected c at move 3", n.Comment, n.MoveNum())
+	}
+	if n, err := g.MainlineNode(0); err != nil || n != g.Root {
+		t.Errorf("got node %v, %v for move 0, expected the root", n, err)
+	}
+	for _, moveNum := range []int{4, -1} {
+		if _, err := g.MainlineNode(moveNum); !errors.Is(err, movetree.ErrApplyTreepath) {
+			t.Errorf("got error %v for move %d, expected %v", err, moveNum, movetree.ErrApplyTreepath)
+		}
+	}
+}
@@ -4,6 +4,7 @@ package point
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 )
 
 // Point is a basic point. Although simple, the member variables are kept
@@ -38,6 +39,22 @@ func (pt *Point) Equal(other *Point) bool {
 	return other != nil && pt.X() == other.X() && pt.Y() == other.Y()
 }
 
+// Less indicates whether this point is ordered before another point: by x,
+// then y.
+func (pt *Point) Less(other *Point) bool {
+	if pt.X() != other.X() {
+		return pt.X() < other.X()
+	}
+	return pt.Y() < other.Y()
+}
+
+// SortList sorts points (in-place) by x, then y.
+func SortList(pts []*Point) {
+	sort.Slice(pts, func(i, j int) bool {
+		return pts[i].Less(pts[j])
+	})
+}
+
 // String converts to string representation of a Point.
 func (pt *Point) String() string {
 	return fmt.Sprintf("{%d,%d}", pt.x, pt.y)
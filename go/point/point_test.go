@@ -2,6 +2,7 @@ package point
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/go-cmp/cmp"
 
@@ -159,6 +160,15 @@ func TestEquality(t *testing.T) {
 	}
 }
 
+func TestSortList(t *testing.T) {
+	pts := []*Point{New(1, 0), New(0, 2), New(1, 1), New(0, 1)}
+	SortList(pts)
+	exp := []*Point{New(0, 1), New(0, 2), New(1, 0), New(1, 1)}
+	if !cmp.Equal(pts, exp) {
+		t.Errorf("got %v, expected %v", pts, exp)
+	}
+}
+
 func TestJSON(t *testing.T) {
 	pt := New(1, 2)
 	by, err := json.Marshal(pt)
@@ -173,3 +183,120 @@ func TestJSON(t *testing.T) {
 		t.Fatalf("got point %v, but expected point %v", back, pt)
 	}
 }
+
+func TestListFromSGF(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		in      []string
+		want    []*Point
+		wantErr error
+	}{
+		{
+			desc: "single points",
+			in:   []string{"aa", "cb"},
+			want: []*Point{New(0, 0), New(2, 1)},
+		},
+		{
+			desc: "compressed rectangle",
+			in:   []string{"ab:bc"},
+			want: []*Point{New(0, 1), New(1, 1), New(0, 2), New(1, 2)},
+		},
+		{
+			desc: "compressed single point",
+			in:   []string{"dd:dd", "aa"},
+			want: []*Point{New(3, 3), New(0, 0)},
+		},
+		{
+			desc:    "reversed rectangle",
+			in:      []string{"bc:ab"},
+			wantErr: SGFConversionErr,
+		},
+		{
+			desc:    "bad corner",
+			in:      []string{"aa:b"},
+			wantErr: SGFConversionErr,
+		},
+		{
+			desc:    "empty point",
+			in:      []string{""},
+			wantErr: SGFConversionErr,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := ListFromSGF(tc.in)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, but wanted %v", err, tc.wantErr)
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("ListFromSGF(%v)=%v, but wanted %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestListToSGF(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		in      []*Point
+		want    []string
+		wantErr error
+	}{
+		{
+			desc: "single points",
+			in:   []*Point{New(2, 1), New(0, 0)},
+			want: []string{"aa", "cb"},
+		},
+		{
+			desc: "rectangle",
+			in:   []*Point{New(0, 1), New(1, 1), New(0, 2), New(1, 2)},
+			want: []string{"ab:bc"},
+		},
+		{
+			desc: "row and leftover",
+			in:   []*Point{New(0, 0), New(1, 0), New(2, 0), New(0, 1), New(1, 1)},
+			want: []string{"aa:ca", "ab", "bb"},
+		},
+		{
+			desc: "pair",
+			in:   []*Point{New(0, 0), New(0, 1)},
+			want: []string{"aa", "ab"},
+		},
+		{
+			desc: "column",
+			in:   []*Point{New(3, 3), New(3, 4), New(3, 5)},
+			want: []string{"dd:df"},
+		},
+		{
+			desc: "duplicates",
+			in:   []*Point{New(0, 0), New(0, 0)},
+			want: []string{"aa"},
+		},
+		{
+			desc:    "out of range",
+			in:      []*Point{New(52, 0)},
+			wantErr: SGFConversionErr,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := ListToSGF(tc.in)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, but wanted %v", err, tc.wantErr)
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("ListToSGF(%v)=%v, but wanted %v", tc.in, got, tc.want)
+			}
+			if err != nil {
+				return
+			}
+			pts, err := ListFromSGF(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(pts) > len(tc.in) {
+				t.Errorf("ListFromSGF(%v) expanded to %d points, but wanted at most %d", got, len(pts), len(tc.in))
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package point
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 var SGFConversionErr = errors.New("error converting point from sgf pointF")
@@ -53,3 +55,104 @@ func toSGF(pt *Point) (string, error) {
 	}
 	return string(pointToSgfMap[pt.X()]) + string(pointToSgfMap[pt.Y()]), nil
 }
+
+// ListFromSGF converts a list of SGF points to Points, expanding compressed
+// point lists. A compressed point list is a rectangle, written as its
+// upper-left and lower-right corners separated by a colon: "aa:bc" is the six
+// points from {0,0} to {1,2}, listed row by row.
+func ListFromSGF(sgfPts []string) ([]*Point, error) {
+	var out []*Point
+	for _, sgfPt := range sgfPts {
+		ul, lr, found := strings.Cut(sgfPt, ":")
+		if !found {
+			pt, err := NewFromSGF(sgfPt)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, pt)
+			continue
+		}
+		from, err := NewFromSGF(ul)
+		if err != nil {
+			return nil, err
+		}
+		to, err := NewFromSGF(lr)
+		if err != nil {
+			return nil, err
+		}
+		if to.X() < from.X() || to.Y() < from.Y() {
+			return nil, fmt.Errorf("%w compressed point list %s must go from the upper-left to the lower-right corner", SGFConversionErr, sgfPt)
+		}
+		for y := from.Y(); y <= to.Y(); y++ {
+			for x := from.X(); x <= to.X(); x++ {
+				out = append(out, New(x, y))
+			}
+		}
+	}
+	return out, nil
+}
+
+// ListToSGF converts a list of Points to SGF points, compressing rectangles of
+// points into compressed point lists. The points are written row by row, and
+// each rectangle is grown first to the right and then down, so the result is
+// deterministic but not necessarily the shortest possible. Rectangles of two
+// points aren't compressed, since that doesn't make them shorter. Duplicate
+// points are written once.
+func ListToSGF(pts []*Point) ([]string, error) {
+	type coord struct{ x, y int }
+	remaining := make(map[coord]bool)
+	var sorted []coord
+	for _, pt := range pts {
+		if _, err := toSGF(pt); err != nil {
+			return nil, err
+		}
+		c := coord{pt.X(), pt.Y()}
+		if !remaining[c] {
+			remaining[c] = true
+			sorted = append(sorted, c)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].y != sorted[j].y {
+			return sorted[i].y < sorted[j].y
+		}
+		return sorted[i].x < sorted[j].x
+	})
+
+	var out []string
+	for _, from := range sorted {
+		if !remaining[from] {
+			continue
+		}
+		to := from
+		for remaining[coord{to.x + 1, from.y}] {
+			to.x++
+		}
+		for fullRow := true; fullRow; {
+			for x := from.x; x <= to.x; x++ {
+				if !remaining[coord{x, to.y + 1}] {
+					fullRow = false
+					break
+				}
+			}
+			if fullRow {
+				to.y++
+			}
+		}
+		if (to.x-from.x+1)*(to.y-from.y+1) == 2 {
+			to = from
+		}
+		for y := from.y; y <= to.y; y++ {
+			for x := from.x; x <= to.x; x++ {
+				delete(remaining, coord{x, y})
+			}
+		}
+
+		s := string(pointToSgfMap[from.x]) + string(pointToSgfMap[from.y])
+		if to != from {
+			s += ":" + string(pointToSgfMap[to.x]) + string(pointToSgfMap[to.y])
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
@@ -8,7 +8,7 @@ import (
 )
 
 // Flatten takes a Path and a MoveTree and returns the root of the flat
-// movetree. The flat movetree ignores the last two nodes of the treepath.
+// movetree. The flat movetree ignores the last node of the treepath.
 func Flatten(tp movetree.Path, g *movetree.MoveTree) (*movetree.MoveTree, error) {
 	b, err := PopulateBoard(tp, g)
 	if err != nil {
@@ -17,7 +17,12 @@ func Flatten(tp movetree.Path, g *movetree.MoveTree) (*movetree.MoveTree, error)
 
 	gflat := movetree.New()
 	gflat.Root.Placements = b.StoneState()
-	gflat.Root.GameInfo.Size = g.Root.GameInfo.Size
+	// The game info is kept, except for the properties describing the start
+	// of the game, which no longer apply to the flattened position.
+	gi := g.Root.GameInfo.Clone()
+	gi.Player = ""
+	gi.Handicap = 0
+	gflat.Root.GameInfo = gi
 
 	for key, value := range g.Root.SGFProperties {
 		gflat.Root.SGFProperties[key] = value
@@ -26,29 +31,23 @@ func Flatten(tp movetree.Path, g *movetree.MoveTree) (*movetree.MoveTree, error)
 	return gflat, nil
 }
 
-// PopulateBoard populates a go board given a MoveTree and Path. Captures are
-// intentionally discarded here. Returns the populated board.
+// PopulateBoard populates a go board given a MoveTree and Path, checking the
+// moves under the game's rules (RU). Captures are intentionally discarded here.
+// Returns the populated board.
+//
+// Setup stones (including cleared points) are applied along the way, so that
+// problems with setup nodes partway through the game are populated correctly.
 func PopulateBoard(tp movetree.Path, g *movetree.MoveTree) (*board.Board, error) {
-	n := g.Root
-	i := n.GameInfo.Size
-
-	b := board.New(i)
-	for _, move := range n.Placements {
-		b.PlaceStone(move)
+	// tp ends at the blunder, so we follow the treepath to the move right
+	// before the blunder using len(tp) - 1;
+	if len(tp) > 0 {
+		tp = tp[:len(tp)-1]
 	}
-
-	// tp ends one move after the blunder, so we follow the treepath
-	// to the move right before the blunder using len(tp) - 2;
-	for i := 0; i < len(tp)-2; i++ {
-		n = n.Next(tp[i])
-		if n == nil {
-			return nil, fmt.Errorf("treepath leads to nil movetree node")
-		}
-
-		_, err := b.PlaceStone(n.Move)
-		if err != nil {
-			return nil, err
-		}
+	b := board.NewRect(g.Root.GameInfo.Dimensions())
+	b.SetRules(g.Root.GameInfo.BoardRules())
+	b, _, err := tp.ApplyToBoard(g.Root, b)
+	if err != nil {
+		return nil, fmt.Errorf("populating board: %w", err)
 	}
 	return b, nil
 }
@@ -1,8 +1,11 @@
 package problems_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/otrego/clamshell/go/board"
 	"github.com/otrego/clamshell/go/movetree"
 	"github.com/otrego/clamshell/go/problems"
 	"github.com/otrego/clamshell/go/prop"
@@ -18,7 +21,7 @@ func TestFlatten(t *testing.T) {
 	}{
 		{
 			desc: "entire game flatten",
-			tp:   "0x140",
+			tp:   "0x139",
 			sgf: `(;FF[4]CA[UTF-8]GM[1]DT[2020-08-05]PB[player1]PW[player2]
 				BR[2k]WR[5d]TM[259200]OT[86400fischer]RE[W+R]SZ[19]
 				KM[6.5]RU[Japanese];B[pd];W[dc];B[qp];W[cq];B[np]
@@ -43,6 +46,11 @@ func TestFlatten(t *testing.T) {
 				;B[ij];W[jk];B[nj];W[ee];B[jd];W[id];B[ie]
 				;W[fe])`,
 		},
+		{
+			desc: "setup node flatten",
+			tp:   "0x4",
+			sgf:  "(;GM[1]SZ[9]AB[aa:cc];W[ee];AE[aa:ba]AW[gg];B[dd];W[ff])",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -61,7 +69,7 @@ func TestFlatten(t *testing.T) {
 			}
 
 			// Test that the boards are identical
-			tpRoot, err := movetree.ParsePath("0x2")
+			tpRoot, err := movetree.ParsePath("0")
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -78,6 +86,14 @@ func TestFlatten(t *testing.T) {
 				t.Errorf("wanted %s but got %s", bWant.String(), bGot.String())
 			}
 
+			// The flattened game info doesn't share values with the game.
+			if gFlat.Root.GameInfo.Komi != nil && gFlat.Root.GameInfo.Komi == g.Root.GameInfo.Komi {
+				t.Errorf("got komi shared between the flattened game and the game")
+			}
+			if gFlat.Root.GameInfo.Result != nil && gFlat.Root.GameInfo.Result == g.Root.GameInfo.Result {
+				t.Errorf("got result shared between the flattened game and the game")
+			}
+
 			// Test that the properties are identical
 			propsWant, err := prop.ConvertNode(g.Root)
 			if err != nil {
@@ -94,3 +110,38 @@ func TestFlatten(t *testing.T) {
 		})
 	}
 }
+
+func TestPopulateBoard_Rules(t *testing.T) {
+	// Three kos, which the players take in turn, so that the sixth move
+	// repeats the starting position.
+	const tripleKo = `(;GM[1]SZ[13]RU[%s]
+		AB[ba][ab][bc][ce][df][cg][bf][bi][aj][bk]
+		AW[ca][db][cc][bb][be][af][bg][ci][dj][ck][bj]
+		;B[cb];W[cf];B[cj];W[bb];B[bf];W[bj];B[ee])`
+
+	testCases := []struct {
+		rules  string
+		expErr error
+	}{
+		{rules: "Japanese"},
+		{rules: "Chinese"},
+		{rules: "Chinese-OGS", expErr: board.SuperkoMove},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.rules, func(t *testing.T) {
+			g, err := sgf.Parse(fmt.Sprintf(tripleKo, tc.rules))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The path ends at the seventh move, so the board is populated up
+			// to the sixth.
+			tp, err := movetree.ParsePath("0x7")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := problems.PopulateBoard(tp, g); !errors.Is(err, tc.expErr) {
+				t.Errorf("got error %v, but wanted %v", err, tc.expErr)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package prop
 import (
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/otrego/clamshell/go/movetree"
 )
@@ -33,6 +32,6 @@ var commentConv = &SGFConverter{
 		if c == "" {
 			return "", nil
 		}
-		return "C[" + strings.Replace(c, "]", "\\]", -1) + "]", nil
+		return "C[" + escapeText(c) + "]", nil
 	},
 }
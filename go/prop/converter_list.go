@@ -7,7 +7,31 @@ var converters = []*SGFConverter{
 	movesConv,
 	komiConv,
 	initPlayerConv,
+	handicapConv,
+	blackPlayerConv,
+	whitePlayerConv,
+	blackRankConv,
+	whiteRankConv,
+	dateConv,
+	resultConv,
+	rulesConv,
+	mainTimeConv,
+	overtimeConv,
+	gameNameConv,
+	eventConv,
+	roundConv,
+	placeConv,
 	commentConv,
+	marksConv,
+	labelsConv,
+	arrowsConv,
+	linesConv,
+	dimmedConv,
+	selectedConv,
+	positionEvalConv,
+	moveEvalConv,
+	hotspotConv,
+	valueConv,
 }
 
 var propToConv = func(conv []*SGFConverter) map[Prop]*SGFConverter {
@@ -0,0 +1,110 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+var ErrDate = errors.New("error converting date property DT")
+
+// dateConv converts the date property DT.
+var dateConv = &SGFConverter{
+	Props: []Prop{"DT"},
+	Scope: RootScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		if len(data) != 1 {
+			return fmt.Errorf("requires exactly 1 value, but had %d: %w", len(data), ErrDate)
+		}
+		dates, err := parseDates(data[0])
+		if err != nil {
+			return err
+		}
+		if n.GameInfo == nil {
+			// For safety, make sure to set create gameinfo if it doesn't exist.
+			n.GameInfo = &movetree.GameInfo{}
+		}
+		n.GameInfo.Dates = dates
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if n.GameInfo == nil || len(n.GameInfo.Dates) == 0 {
+			return "", nil
+		}
+		var out []string
+		for _, d := range n.GameInfo.Dates {
+			if err := validateDate(d); err != nil {
+				return "", err
+			}
+			out = append(out, d.String())
+		}
+		return "DT[" + strings.Join(out, ",") + "]", nil
+	},
+}
+
+// parseDates parses a list of dates in the SGF format. Dates are written as
+// YYYY-MM-DD, YYYY-MM, or YYYY, and separated by commas. After the first
+// date, a date can be shortened by leaving out the parts that are the same as
+// in the previous date:
+//
+//	1996-05-06,07,08  becomes 1996-05-06, 1996-05-07, 1996-05-08
+//	1996-05,06        becomes 1996-05, 1996-06
+//	1996-12-27,28,1997-01-03,04
+func parseDates(s string) ([]movetree.Date, error) {
+	var out []movetree.Date
+	var prev *movetree.Date
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		var nums []int
+		for _, f := range strings.Split(part, "-") {
+			if f == "" || strings.Trim(f, "0123456789") != "" {
+				return nil, fmt.Errorf("invalid date %q in %q: %w", part, s, ErrDate)
+			}
+			v, _ := strconv.Atoi(f)
+			nums = append(nums, v)
+		}
+		hasYear := len(strings.SplitN(part, "-", 2)[0]) == 4
+
+		var d movetree.Date
+		switch {
+		case len(nums) == 3 && hasYear:
+			d = movetree.Date{Year: nums[0], Month: time.Month(nums[1]), Day: nums[2]}
+		case len(nums) == 2 && hasYear:
+			d = movetree.Date{Year: nums[0], Month: time.Month(nums[1])}
+		case len(nums) == 1 && hasYear:
+			d = movetree.Date{Year: nums[0]}
+		case len(nums) == 2 && prev != nil && prev.Day != 0:
+			// MM-DD, in the same year as the previous date.
+			d = movetree.Date{Year: prev.Year, Month: time.Month(nums[0]), Day: nums[1]}
+		case len(nums) == 1 && prev != nil && prev.Day != 0:
+			// DD, in the same month as the previous date.
+			d = movetree.Date{Year: prev.Year, Month: prev.Month, Day: nums[0]}
+		case len(nums) == 1 && prev != nil && prev.Month != 0:
+			// MM, in the same year as the previous date.
+			d = movetree.Date{Year: prev.Year, Month: time.Month(nums[0])}
+		default:
+			return nil, fmt.Errorf("invalid date %q in %q: %w", part, s, ErrDate)
+		}
+		if err := validateDate(d); err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+		prev = &out[len(out)-1]
+	}
+	return out, nil
+}
+
+// validateDate checks that the month and day of a date exist.
+func validateDate(d movetree.Date) error {
+	if d.Year < 0 || d.Year > 9999 || d.Month < 0 || d.Month > 12 || d.Day < 0 || (d.Month == 0 && d.Day != 0) {
+		return fmt.Errorf("invalid date %v: %w", d, ErrDate)
+	}
+	if d.Day != 0 && time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC).Day() != d.Day {
+		return fmt.Errorf("invalid date %v: day %d doesn't exist in the month: %w", d, d.Day, ErrDate)
+	}
+	return nil
+}
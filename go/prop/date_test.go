@@ -0,0 +1,127 @@
+package prop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+func TestConvertFromSGF_Date(t *testing.T) {
+	testCases := []fromSGFTestCase{
+		{
+			desc: "Single date",
+			prop: "DT",
+			data: []string{"2020-08-05"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Dates: []movetree.Date{{Year: 2020, Month: time.August, Day: 5}},
+				}
+			},
+		},
+		{
+			desc: "Partial dates",
+			prop: "DT",
+			data: []string{"1996-05,06"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Dates: []movetree.Date{
+						{Year: 1996, Month: time.May},
+						{Year: 1996, Month: time.June},
+					},
+				}
+			},
+		},
+		{
+			desc: "Shortened dates",
+			prop: "DT",
+			data: []string{"1996-12-27,28,1997-01-03,02-04"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Dates: []movetree.Date{
+						{Year: 1996, Month: time.December, Day: 27},
+						{Year: 1996, Month: time.December, Day: 28},
+						{Year: 1997, Month: time.January, Day: 3},
+						{Year: 1997, Month: time.February, Day: 4},
+					},
+				}
+			},
+		},
+		{
+			desc: "Year",
+			prop: "DT",
+			data: []string{"1846"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Dates: []movetree.Date{{Year: 1846}},
+				}
+			},
+		},
+		{
+			desc:        "Free-form date",
+			prop:        "DT",
+			data:        []string{"August 5th"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrDate,
+		},
+		{
+			desc:        "Invalid day",
+			prop:        "DT",
+			data:        []string{"2021-02-29"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrDate,
+		},
+		{
+			desc:        "Invalid month",
+			prop:        "DT",
+			data:        []string{"2021-13"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrDate,
+		},
+		{
+			desc:        "Shortened first date",
+			prop:        "DT",
+			data:        []string{"05-06"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrDate,
+		},
+		{
+			desc:        "Day after a year",
+			prop:        "DT",
+			data:        []string{"1996,05"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrDate,
+		},
+	}
+
+	testConvertFromSGFCases(t, testCases)
+}
+
+func TestConvertNode_Date(t *testing.T) {
+	testCases := []convertNodeTestCase{
+		{
+			desc: "Dates",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Dates: []movetree.Date{
+						{Year: 1996, Month: time.December, Day: 27},
+						{Year: 1997, Month: time.January},
+						{Year: 1998},
+					},
+				}
+			},
+			expOut: "DT[1996-12-27,1997-01,1998]",
+		},
+		{
+			desc: "Invalid date",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Dates: []movetree.Date{{Year: 2021, Month: time.April, Day: 31}},
+				}
+			},
+			expErr: ErrDate,
+		},
+	}
+
+	testConvertNodeCases(t, testCases)
+}
@@ -0,0 +1,147 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+var ErrEvaluation = errors.New("error converting evaluation property")
+
+// positionEvalConv converts the position evaluation properties GB, GW, DM,
+// and UC. Only one can be set on a node.
+var positionEvalConv = &SGFConverter{
+	Props: []Prop{"GB", "GW", "DM", "UC"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		eval := movetree.PositionEval(prop)
+		if cur := n.Evaluation.Position; cur != "" && cur != eval {
+			return fmt.Errorf("%w: for property %s: node already has position evaluation %s", ErrEvaluation, prop, cur)
+		}
+		em, err := parseEmphasis(prop, data)
+		if err != nil {
+			return err
+		}
+		n.Evaluation.Position = eval
+		n.Evaluation.PositionEmphasis = em
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		ev := n.Evaluation
+		switch ev.Position {
+		case "":
+			return "", nil
+		case movetree.GoodForBlack, movetree.GoodForWhite, movetree.Even, movetree.Unclear:
+			return emphasisToSGF(string(ev.Position), ev.PositionEmphasis)
+		}
+		return "", fmt.Errorf("%w: unknown position evaluation %q", ErrEvaluation, ev.Position)
+	},
+}
+
+// moveEvalConv converts the move evaluation properties BM, TE, DO, and IT.
+// Only one can be set on a node, and only BM and TE have an emphasis.
+var moveEvalConv = &SGFConverter{
+	Props: []Prop{"BM", "TE", "DO", "IT"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		eval := movetree.MoveEval(prop)
+		if cur := n.Evaluation.Move; cur != "" && cur != eval {
+			return fmt.Errorf("%w: for property %s: node already has move evaluation %s", ErrEvaluation, prop, cur)
+		}
+		var em movetree.Emphasis
+		switch eval {
+		case movetree.BadMove, movetree.Tesuji:
+			var err error
+			if em, err = parseEmphasis(prop, data); err != nil {
+				return err
+			}
+		default:
+			if len(data) > 1 || (len(data) == 1 && data[0] != "") {
+				return fmt.Errorf("%w: for property %s: takes no value, but had %v", ErrEvaluation, prop, data)
+			}
+		}
+		n.Evaluation.Move = eval
+		n.Evaluation.MoveEmphasis = em
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		ev := n.Evaluation
+		switch ev.Move {
+		case "":
+			return "", nil
+		case movetree.BadMove, movetree.Tesuji:
+			return emphasisToSGF(string(ev.Move), ev.MoveEmphasis)
+		case movetree.Doubtful, movetree.Interesting:
+			return string(ev.Move) + "[]", nil
+		}
+		return "", fmt.Errorf("%w: unknown move evaluation %q", ErrEvaluation, ev.Move)
+	},
+}
+
+// hotspotConv converts the hotspot property HO.
+var hotspotConv = &SGFConverter{
+	Props: []Prop{"HO"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		em, err := parseEmphasis(prop, data)
+		if err != nil {
+			return err
+		}
+		n.Evaluation.Hotspot = em
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if n.Evaluation.Hotspot == 0 {
+			return "", nil
+		}
+		return emphasisToSGF("HO", n.Evaluation.Hotspot)
+	},
+}
+
+// valueConv converts the position value property V.
+var valueConv = &SGFConverter{
+	Props: []Prop{"V"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		if len(data) != 1 {
+			return fmt.Errorf("%w: for property %s: requires exactly 1 value, but had %d", ErrEvaluation, prop, len(data))
+		}
+		v, err := strconv.ParseFloat(data[0], 64)
+		if err != nil {
+			return fmt.Errorf("%w: for property %s: invalid value %q", ErrEvaluation, prop, data[0])
+		}
+		n.Evaluation.Value = &v
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if n.Evaluation.Value == nil {
+			return "", nil
+		}
+		return "V[" + strconv.FormatFloat(*n.Evaluation.Value, 'f', -1, 64) + "]", nil
+	},
+}
+
+// parseEmphasis parses the value of a property with an emphasis, which is
+// either 1 (normal) or 2 (emphasized).
+func parseEmphasis(prop string, data []string) (movetree.Emphasis, error) {
+	if len(data) != 1 {
+		return 0, fmt.Errorf("%w: for property %s: requires exactly 1 value, but had %d", ErrEvaluation, prop, len(data))
+	}
+	switch data[0] {
+	case "1":
+		return movetree.Normal, nil
+	case "2":
+		return movetree.Emphasized, nil
+	}
+	return 0, fmt.Errorf("%w: for property %s: value must be 1 or 2, but was %q", ErrEvaluation, prop, data[0])
+}
+
+// emphasisToSGF writes a property with an emphasis.
+func emphasisToSGF(prop string, em movetree.Emphasis) (string, error) {
+	if em != movetree.Normal && em != movetree.Emphasized {
+		return "", fmt.Errorf("%w: for property %s: emphasis must be 1 or 2, but was %d", ErrEvaluation, prop, em)
+	}
+	return fmt.Sprintf("%s[%d]", prop, em), nil
+}
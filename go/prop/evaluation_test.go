@@ -0,0 +1,145 @@
+package prop
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+func TestConvertFromSGF_Evaluation(t *testing.T) {
+	testCases := []fromSGFTestCase{
+		{
+			desc: "Good for black",
+			prop: "GB",
+			data: []string{"2"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{Position: movetree.GoodForBlack, PositionEmphasis: movetree.Emphasized}
+			},
+		},
+		{
+			desc: "Unclear",
+			prop: "UC",
+			data: []string{"1"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{Position: movetree.Unclear, PositionEmphasis: movetree.Normal}
+			},
+		},
+		{
+			desc: "Bad move",
+			prop: "BM",
+			data: []string{"1"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{Move: movetree.BadMove, MoveEmphasis: movetree.Normal}
+			},
+		},
+		{
+			desc: "Doubtful",
+			prop: "DO",
+			data: []string{""},
+			makeExpNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{Move: movetree.Doubtful}
+			},
+		},
+		{
+			desc: "Hotspot",
+			prop: "HO",
+			data: []string{"2"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{Hotspot: movetree.Emphasized}
+			},
+		},
+		{
+			desc: "Value",
+			prop: "V",
+			data: []string{"-4.5"},
+			makeExpNode: func(n *movetree.Node) {
+				v := -4.5
+				n.Evaluation = movetree.Evaluation{Value: &v}
+			},
+		},
+		{
+			desc:        "Bad emphasis",
+			prop:        "TE",
+			data:        []string{"3"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrEvaluation,
+		},
+		{
+			desc:        "Interesting with a value",
+			prop:        "IT",
+			data:        []string{"1"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrEvaluation,
+		},
+		{
+			desc:        "Bad value",
+			prop:        "V",
+			data:        []string{"lots"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrEvaluation,
+		},
+	}
+
+	testConvertFromSGFCases(t, testCases)
+}
+
+func TestConvertFromSGF_EvaluationConflict(t *testing.T) {
+	n := movetree.NewNode()
+	if err := ProcessPropertyData(n, "BM", []string{"1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ProcessPropertyData(n, "TE", []string{"1"}); err == nil {
+		t.Errorf("got no error for BM and TE on the same node, expected %v", ErrEvaluation)
+	}
+	if err := ProcessPropertyData(n, "GW", []string{"1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ProcessPropertyData(n, "DM", []string{"1"}); err == nil {
+		t.Errorf("got no error for GW and DM on the same node, expected %v", ErrEvaluation)
+	}
+}
+
+func TestConvertNode_Evaluation(t *testing.T) {
+	testCases := []convertNodeTestCase{
+		{
+			desc: "Position and move",
+			makeNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{
+					Position:         movetree.GoodForWhite,
+					PositionEmphasis: movetree.Normal,
+					Move:             movetree.Tesuji,
+					MoveEmphasis:     movetree.Emphasized,
+				}
+			},
+			expOut: "GW[1]TE[2]",
+		},
+		{
+			desc: "Interesting, hotspot, and value",
+			makeNode: func(n *movetree.Node) {
+				v := 12.0
+				n.Evaluation = movetree.Evaluation{
+					Move:    movetree.Interesting,
+					Hotspot: movetree.Normal,
+					Value:   &v,
+				}
+			},
+			expOut: "IT[]HO[1]V[12]",
+		},
+		{
+			desc: "Missing emphasis",
+			makeNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{Move: movetree.BadMove}
+			},
+			expErr: ErrEvaluation,
+		},
+		{
+			desc: "Unknown evaluation",
+			makeNode: func(n *movetree.Node) {
+				n.Evaluation = movetree.Evaluation{Position: "XX", PositionEmphasis: movetree.Normal}
+			},
+			expErr: ErrEvaluation,
+		},
+	}
+
+	testConvertNodeCases(t, testCases)
+}
@@ -0,0 +1,57 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+var ErrGameInfo = errors.New("error converting game info property")
+
+var (
+	blackPlayerConv = textInfoConv("PB", func(gi *movetree.GameInfo) *string { return &gi.BlackPlayer })
+	whitePlayerConv = textInfoConv("PW", func(gi *movetree.GameInfo) *string { return &gi.WhitePlayer })
+	blackRankConv   = textInfoConv("BR", func(gi *movetree.GameInfo) *string { return &gi.BlackRank })
+	whiteRankConv   = textInfoConv("WR", func(gi *movetree.GameInfo) *string { return &gi.WhiteRank })
+	rulesConv       = textInfoConv("RU", func(gi *movetree.GameInfo) *string { return &gi.Rules })
+	overtimeConv    = textInfoConv("OT", func(gi *movetree.GameInfo) *string { return &gi.Overtime })
+	gameNameConv    = textInfoConv("GN", func(gi *movetree.GameInfo) *string { return &gi.GameName })
+	eventConv       = textInfoConv("EV", func(gi *movetree.GameInfo) *string { return &gi.Event })
+	roundConv       = textInfoConv("RO", func(gi *movetree.GameInfo) *string { return &gi.Round })
+	placeConv       = textInfoConv("PC", func(gi *movetree.GameInfo) *string { return &gi.Place })
+)
+
+// textInfoConv creates a converter for a root property with a single text
+// value, stored in the game info field returned by field.
+func textInfoConv(p Prop, field func(gi *movetree.GameInfo) *string) *SGFConverter {
+	return &SGFConverter{
+		Props: []Prop{p},
+		Scope: RootScope,
+		From: func(n *movetree.Node, prop string, data []string) error {
+			if len(data) != 1 {
+				return fmt.Errorf("%w: for property %s: requires exactly 1 value, but had %d", ErrGameInfo, prop, len(data))
+			}
+			if n.GameInfo == nil {
+				// For safety, make sure to set create gameinfo if it doesn't exist.
+				n.GameInfo = &movetree.GameInfo{}
+			}
+			*field(n.GameInfo) = data[0]
+			return nil
+		},
+		To: func(n *movetree.Node) (string, error) {
+			if n.GameInfo == nil || *field(n.GameInfo) == "" {
+				return "", nil
+			}
+			return string(p) + "[" + escapeText(*field(n.GameInfo)) + "]", nil
+		},
+	}
+}
+
+// escapeText escapes a text value for writing as SGF property data. The
+// parser keeps backslashes that don't precede a ']', so only ']' needs
+// escaping.
+func escapeText(s string) string {
+	return strings.Replace(s, "]", "\\]", -1)
+}
@@ -0,0 +1,101 @@
+package prop
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+func TestConvertFromSGF_GameInfoText(t *testing.T) {
+	testCases := []fromSGFTestCase{
+		{
+			desc: "Black player",
+			prop: "PB",
+			data: []string{"Honinbo Shusaku"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					BlackPlayer: "Honinbo Shusaku",
+				}
+			},
+		},
+		{
+			desc: "White rank",
+			prop: "WR",
+			data: []string{"5d"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					WhiteRank: "5d",
+				}
+			},
+		},
+		{
+			desc: "Rules",
+			prop: "RU",
+			data: []string{"Japanese"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Rules: "Japanese",
+				}
+			},
+		},
+		{
+			desc: "Overtime",
+			prop: "OT",
+			data: []string{"5x30 byo-yomi"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Overtime: "5x30 byo-yomi",
+				}
+			},
+		},
+		{
+			desc:        "Multiple values",
+			prop:        "EV",
+			data:        []string{"Meijin", "Honinbo"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrGameInfo,
+		},
+	}
+
+	testConvertFromSGFCases(t, testCases)
+}
+
+func TestConvertNode_GameInfoText(t *testing.T) {
+	testCases := []convertNodeTestCase{
+		{
+			desc: "Players and ranks",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					BlackPlayer: "Black",
+					WhitePlayer: "White",
+					BlackRank:   "2k",
+					WhiteRank:   "5d",
+				}
+			},
+			expOut: "PB[Black]PW[White]BR[2k]WR[5d]",
+		},
+		{
+			desc: "Event details",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					GameName: "Ear-reddening game",
+					Event:    "Castle game",
+					Round:    "1",
+					Place:    "Osaka",
+				}
+			},
+			expOut: "GN[Ear-reddening game]EV[Castle game]RO[1]PC[Osaka]",
+		},
+		{
+			desc: "Escaped text",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Event: "Cup [final]",
+				}
+			},
+			expOut: "EV[Cup [final\\]]",
+		},
+	}
+
+	testConvertNodeCases(t, testCases)
+}
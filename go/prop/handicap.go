@@ -0,0 +1,44 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+var ErrHandicap = errors.New("error converting handicap property HA")
+
+// handicapConv converts the handicap property HA.
+var handicapConv = &SGFConverter{
+	Props: []Prop{"HA"},
+	Scope: RootScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		if len(data) != 1 {
+			return fmt.Errorf("requires exactly 1 value, but had %d: %w", len(data), ErrHandicap)
+		}
+		ha, err := strconv.Atoi(data[0])
+		if err != nil {
+			return fmt.Errorf("invalid value %q: %w", data[0], ErrHandicap)
+		}
+		if ha < 0 {
+			return fmt.Errorf("handicap was %d, but must not be negative: %w", ha, ErrHandicap)
+		}
+		if n.GameInfo == nil {
+			// For safety, make sure to set create gameinfo if it doesn't exist.
+			n.GameInfo = &movetree.GameInfo{}
+		}
+		n.GameInfo.Handicap = ha
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if n.GameInfo == nil || n.GameInfo.Handicap == 0 {
+			return "", nil
+		}
+		if n.GameInfo.Handicap < 0 {
+			return "", fmt.Errorf("handicap was %d, but must not be negative: %w", n.GameInfo.Handicap, ErrHandicap)
+		}
+		return fmt.Sprintf("HA[%d]", n.GameInfo.Handicap), nil
+	},
+}
@@ -0,0 +1,77 @@
+package prop
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+func TestConvertFromSGF_Handicap(t *testing.T) {
+	testCases := []fromSGFTestCase{
+		{
+			desc: "Handicap",
+			prop: "HA",
+			data: []string{"4"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Handicap: 4,
+				}
+			},
+		},
+		{
+			desc:        "Missing value",
+			prop:        "HA",
+			data:        []string{},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrHandicap,
+		},
+		{
+			desc:        "Not a number",
+			prop:        "HA",
+			data:        []string{"four"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrHandicap,
+		},
+		{
+			desc:        "Negative",
+			prop:        "HA",
+			data:        []string{"-2"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrHandicap,
+		},
+	}
+
+	testConvertFromSGFCases(t, testCases)
+}
+
+func TestConvertNode_Handicap(t *testing.T) {
+	testCases := []convertNodeTestCase{
+		{
+			desc: "Handicap",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Handicap: 9,
+				}
+			},
+			expOut: "HA[9]",
+		},
+		{
+			desc: "No handicap",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{}
+			},
+			expOut: "",
+		},
+		{
+			desc: "Negative",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Handicap: -1,
+				}
+			},
+			expErr: ErrHandicap,
+		},
+	}
+
+	testConvertNodeCases(t, testCases)
+}
@@ -0,0 +1,47 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+var ErrMainTime = errors.New("error converting main time property TM")
+
+// mainTimeConv converts the main time property TM, which is in seconds.
+var mainTimeConv = &SGFConverter{
+	Props: []Prop{"TM"},
+	Scope: RootScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		if len(data) != 1 {
+			return fmt.Errorf("requires exactly 1 value, but had %d: %w", len(data), ErrMainTime)
+		}
+		secs, err := strconv.ParseFloat(data[0], 64)
+		if err != nil {
+			return fmt.Errorf("invalid value %q: %w", data[0], ErrMainTime)
+		}
+		if secs < 0 {
+			return fmt.Errorf("main time was %v, but must not be negative: %w", secs, ErrMainTime)
+		}
+		if n.GameInfo == nil {
+			// For safety, make sure to set create gameinfo if it doesn't exist.
+			n.GameInfo = &movetree.GameInfo{}
+		}
+		d := time.Duration(secs * float64(time.Second))
+		n.GameInfo.MainTime = &d
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if n.GameInfo == nil || n.GameInfo.MainTime == nil {
+			return "", nil
+		}
+		d := *n.GameInfo.MainTime
+		if d < 0 {
+			return "", fmt.Errorf("main time was %v, but must not be negative: %w", d, ErrMainTime)
+		}
+		return "TM[" + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "]", nil
+	},
+}
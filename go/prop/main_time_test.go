@@ -0,0 +1,78 @@
+package prop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+func TestConvertFromSGF_MainTime(t *testing.T) {
+	testCases := []fromSGFTestCase{
+		{
+			desc: "Main time",
+			prop: "TM",
+			data: []string{"259200"},
+			makeExpNode: func(n *movetree.Node) {
+				d := 72 * time.Hour
+				n.GameInfo = &movetree.GameInfo{
+					MainTime: &d,
+				}
+			},
+		},
+		{
+			desc: "Fractional seconds",
+			prop: "TM",
+			data: []string{"1.5"},
+			makeExpNode: func(n *movetree.Node) {
+				d := 1500 * time.Millisecond
+				n.GameInfo = &movetree.GameInfo{
+					MainTime: &d,
+				}
+			},
+		},
+		{
+			desc:        "Not a number",
+			prop:        "TM",
+			data:        []string{"1h"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrMainTime,
+		},
+		{
+			desc:        "Negative",
+			prop:        "TM",
+			data:        []string{"-60"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrMainTime,
+		},
+	}
+
+	testConvertFromSGFCases(t, testCases)
+}
+
+func TestConvertNode_MainTime(t *testing.T) {
+	testCases := []convertNodeTestCase{
+		{
+			desc: "Main time",
+			makeNode: func(n *movetree.Node) {
+				d := 90 * time.Second
+				n.GameInfo = &movetree.GameInfo{
+					MainTime: &d,
+				}
+			},
+			expOut: "TM[90]",
+		},
+		{
+			desc: "No main time",
+			makeNode: func(n *movetree.Node) {
+				d := time.Duration(0)
+				n.GameInfo = &movetree.GameInfo{
+					MainTime: &d,
+				}
+			},
+			expOut: "TM[0]",
+		},
+	}
+
+	testConvertNodeCases(t, testCases)
+}
@@ -0,0 +1,225 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
+)
+
+var ErrMarkup = errors.New("error converting markup property")
+
+// markTypes are the mark properties, in the order they're written.
+var markTypes = []movetree.MarkType{
+	movetree.Triangle,
+	movetree.Square,
+	movetree.Circle,
+	movetree.XMark,
+}
+
+// marksConv converts the mark properties TR, SQ, CR, and MA.
+var marksConv = &SGFConverter{
+	Props: []Prop{"TR", "SQ", "CR", "MA"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		pts, err := point.ListFromSGF(data)
+		if err != nil {
+			return fmt.Errorf("%w: for property %s: %v", ErrMarkup, prop, err)
+		}
+		for _, pt := range pts {
+			n.Markup.Marks = append(n.Markup.Marks, &movetree.Mark{Point: pt, Type: movetree.MarkType(prop)})
+		}
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		for _, m := range n.Markup.Marks {
+			if !isMarkType(m.Type) {
+				return "", fmt.Errorf("%w: unknown mark type %q", ErrMarkup, m.Type)
+			}
+		}
+		var sb strings.Builder
+		for _, typ := range markTypes {
+			var pts []*point.Point
+			for _, m := range n.Markup.Marks {
+				if m.Type == typ {
+					pts = append(pts, m.Point)
+				}
+			}
+			s, err := pointListToSGF(string(typ), pts)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(s)
+		}
+		return sb.String(), nil
+	},
+}
+
+// labelsConv converts the label property LB, whose values have the form
+// point:text.
+var labelsConv = &SGFConverter{
+	Props: []Prop{"LB"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		for _, d := range data {
+			sgfPt, text, found := strings.Cut(d, ":")
+			if !found {
+				return fmt.Errorf("%w: for property %s: label %q must have the form point:text", ErrMarkup, prop, d)
+			}
+			pt, err := point.NewFromSGF(sgfPt)
+			if err != nil {
+				return fmt.Errorf("%w: for property %s: %v", ErrMarkup, prop, err)
+			}
+			n.Markup.Labels = append(n.Markup.Labels, &movetree.Label{Point: pt, Text: text})
+		}
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if len(n.Markup.Labels) == 0 {
+			return "", nil
+		}
+		var sb strings.Builder
+		sb.WriteString("LB")
+		for _, l := range n.Markup.Labels {
+			sgfPt, err := l.Point.ToSGF()
+			if err != nil {
+				return "", fmt.Errorf("%w: for property LB: %v", ErrMarkup, err)
+			}
+			sb.WriteString("[" + sgfPt + ":" + escapeText(l.Text) + "]")
+		}
+		return sb.String(), nil
+	},
+}
+
+// arrowsConv converts the arrow property AR.
+var arrowsConv = lineConv("AR", func(m *movetree.Markup) *[]*movetree.Line { return &m.Arrows })
+
+// linesConv converts the line property LN.
+var linesConv = lineConv("LN", func(m *movetree.Markup) *[]*movetree.Line { return &m.Lines })
+
+// lineConv creates a converter for a property whose values are lines between
+// two points, with the form point:point, stored in the markup field returned
+// by field.
+func lineConv(p Prop, field func(m *movetree.Markup) *[]*movetree.Line) *SGFConverter {
+	return &SGFConverter{
+		Props: []Prop{p},
+		Scope: AllScope,
+		From: func(n *movetree.Node, prop string, data []string) error {
+			lines := field(&n.Markup)
+			for _, d := range data {
+				from, to, found := strings.Cut(d, ":")
+				if !found {
+					return fmt.Errorf("%w: for property %s: %q must have the form point:point", ErrMarkup, prop, d)
+				}
+				fromPt, err := point.NewFromSGF(from)
+				if err != nil {
+					return fmt.Errorf("%w: for property %s: %v", ErrMarkup, prop, err)
+				}
+				toPt, err := point.NewFromSGF(to)
+				if err != nil {
+					return fmt.Errorf("%w: for property %s: %v", ErrMarkup, prop, err)
+				}
+				if fromPt.Equal(toPt) {
+					return fmt.Errorf("%w: for property %s: %q must be between different points", ErrMarkup, prop, d)
+				}
+				*lines = append(*lines, &movetree.Line{From: fromPt, To: toPt})
+			}
+			return nil
+		},
+		To: func(n *movetree.Node) (string, error) {
+			lines := *field(&n.Markup)
+			if len(lines) == 0 {
+				return "", nil
+			}
+			var sb strings.Builder
+			sb.WriteString(string(p))
+			for _, l := range lines {
+				from, err := l.From.ToSGF()
+				if err != nil {
+					return "", fmt.Errorf("%w: for property %s: %v", ErrMarkup, p, err)
+				}
+				to, err := l.To.ToSGF()
+				if err != nil {
+					return "", fmt.Errorf("%w: for property %s: %v", ErrMarkup, p, err)
+				}
+				sb.WriteString("[" + from + ":" + to + "]")
+			}
+			return sb.String(), nil
+		},
+	}
+}
+
+// dimmedConv converts the dim property DD. An empty DD[] clears the dimmed
+// points, and so is kept as an empty, non-nil list.
+var dimmedConv = &SGFConverter{
+	Props: []Prop{"DD"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		if len(data) == 1 && data[0] == "" {
+			n.Markup.Dimmed = []*point.Point{}
+			return nil
+		}
+		pts, err := point.ListFromSGF(data)
+		if err != nil {
+			return fmt.Errorf("%w: for property %s: %v", ErrMarkup, prop, err)
+		}
+		n.Markup.Dimmed = append(n.Markup.Dimmed, pts...)
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if n.Markup.Dimmed == nil {
+			return "", nil
+		}
+		if len(n.Markup.Dimmed) == 0 {
+			return "DD[]", nil
+		}
+		return pointListToSGF("DD", n.Markup.Dimmed)
+	},
+}
+
+// selectedConv converts the selected-points property SL.
+var selectedConv = &SGFConverter{
+	Props: []Prop{"SL"},
+	Scope: AllScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		pts, err := point.ListFromSGF(data)
+		if err != nil {
+			return fmt.Errorf("%w: for property %s: %v", ErrMarkup, prop, err)
+		}
+		n.Markup.Selected = append(n.Markup.Selected, pts...)
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		return pointListToSGF("SL", n.Markup.Selected)
+	},
+}
+
+// pointListToSGF writes a property with a list of points, returning the empty
+// string if there are no points. Point lists are written in compressed form,
+// like the stone placements.
+func pointListToSGF(prop string, pts []*point.Point) (string, error) {
+	if len(pts) == 0 {
+		return "", nil
+	}
+	sgfPts, err := point.ListToSGF(pts)
+	if err != nil {
+		return "", fmt.Errorf("%w: for property %s: %v", ErrMarkup, prop, err)
+	}
+	var sb strings.Builder
+	sb.WriteString(prop)
+	for _, pt := range sgfPts {
+		sb.WriteString("[" + pt + "]")
+	}
+	return sb.String(), nil
+}
+
+func isMarkType(typ movetree.MarkType) bool {
+	for _, t := range markTypes {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package prop
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestConvertFromSGF_Markup(t *testing.T) {
+	testCases := []fromSGFTestCase{
+		{
+			desc: "Triangles",
+			prop: "TR",
+			data: []string{"aa", "bb"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Marks = []*movetree.Mark{
+					{Point: point.New(0, 0), Type: movetree.Triangle},
+					{Point: point.New(1, 1), Type: movetree.Triangle},
+				}
+			},
+		},
+		{
+			desc: "Compressed X marks",
+			prop: "MA",
+			data: []string{"aa:ab"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Marks = []*movetree.Mark{
+					{Point: point.New(0, 0), Type: movetree.XMark},
+					{Point: point.New(0, 1), Type: movetree.XMark},
+				}
+			},
+		},
+		{
+			desc: "Labels",
+			prop: "LB",
+			data: []string{"aa:A", "cd:1:2"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Labels = []*movetree.Label{
+					{Point: point.New(0, 0), Text: "A"},
+					{Point: point.New(2, 3), Text: "1:2"},
+				}
+			},
+		},
+		{
+			desc: "Arrows",
+			prop: "AR",
+			data: []string{"aa:cc"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Arrows = []*movetree.Line{
+					{From: point.New(0, 0), To: point.New(2, 2)},
+				}
+			},
+		},
+		{
+			desc: "Lines",
+			prop: "LN",
+			data: []string{"ab:ba"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Lines = []*movetree.Line{
+					{From: point.New(0, 1), To: point.New(1, 0)},
+				}
+			},
+		},
+		{
+			desc: "Dimmed",
+			prop: "DD",
+			data: []string{"aa:bb"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Dimmed = []*point.Point{
+					point.New(0, 0), point.New(1, 0), point.New(0, 1), point.New(1, 1),
+				}
+			},
+		},
+		{
+			desc: "Dimming cleared",
+			prop: "DD",
+			data: []string{""},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Dimmed = []*point.Point{}
+			},
+		},
+		{
+			desc: "Selected",
+			prop: "SL",
+			data: []string{"ss"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Markup.Selected = []*point.Point{point.New(18, 18)}
+			},
+		},
+		{
+			desc:        "Bad point",
+			prop:        "SQ",
+			data:        []string{"a"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrMarkup,
+		},
+		{
+			desc:        "Label without text",
+			prop:        "LB",
+			data:        []string{"aa"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrMarkup,
+		},
+		{
+			desc:        "Arrow to itself",
+			prop:        "AR",
+			data:        []string{"aa:aa"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrMarkup,
+		},
+	}
+
+	testConvertFromSGFCases(t, testCases)
+}
+
+func TestConvertNode_Markup(t *testing.T) {
+	testCases := []convertNodeTestCase{
+		{
+			desc: "Marks",
+			makeNode: func(n *movetree.Node) {
+				n.Markup.Marks = []*movetree.Mark{
+					{Point: point.New(1, 1), Type: movetree.Circle},
+					{Point: point.New(0, 1), Type: movetree.Triangle},
+					{Point: point.New(1, 0), Type: movetree.Triangle},
+				}
+			},
+			expOut: "TR[ba][ab]CR[bb]",
+		},
+		{
+			desc: "Compressed marks",
+			makeNode: func(n *movetree.Node) {
+				n.Markup.Marks = []*movetree.Mark{
+					{Point: point.New(0, 0), Type: movetree.XMark},
+					{Point: point.New(1, 0), Type: movetree.XMark},
+					{Point: point.New(0, 1), Type: movetree.XMark},
+					{Point: point.New(1, 1), Type: movetree.XMark},
+				}
+				n.Markup.Selected = []*point.Point{point.New(2, 0), point.New(2, 1), point.New(2, 2)}
+			},
+			expOut: "MA[aa:bb]SL[ca:cc]",
+		},
+		{
+			desc: "Labels and lines",
+			makeNode: func(n *movetree.Node) {
+				n.Markup.Labels = []*movetree.Label{{Point: point.New(0, 0), Text: "[A]"}}
+				n.Markup.Arrows = []*movetree.Line{{From: point.New(0, 0), To: point.New(2, 2)}}
+				n.Markup.Lines = []*movetree.Line{{From: point.New(1, 0), To: point.New(0, 1)}}
+			},
+			expOut: "LB[aa:[A\\]]AR[aa:cc]LN[ba:ab]",
+		},
+		{
+			desc: "Dimmed and selected",
+			makeNode: func(n *movetree.Node) {
+				n.Markup.Dimmed = []*point.Point{point.New(2, 2)}
+				n.Markup.Selected = []*point.Point{point.New(3, 3)}
+			},
+			expOut: "DD[cc]SL[dd]",
+		},
+		{
+			desc: "Dimming cleared",
+			makeNode: func(n *movetree.Node) {
+				n.Markup.Dimmed = []*point.Point{}
+			},
+			expOut: "DD[]",
+		},
+		{
+			desc: "Unknown mark",
+			makeNode: func(n *movetree.Node) {
+				n.Markup.Marks = []*movetree.Mark{{Point: point.New(0, 0), Type: "XX"}}
+			},
+			expErr: ErrMarkup,
+		},
+	}
+
+	testConvertNodeCases(t, testCases)
+}
@@ -6,11 +6,14 @@ import (
 	"github.com/otrego/clamshell/go/color"
 	"github.com/otrego/clamshell/go/move"
 	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
 )
 
-// placementsConv converts stone-placements AW, AB.
+// placementsConv converts stone-placements AB, AW, and AE. Cleared points (AE)
+// are placements with an empty color. Point lists are read and written in
+// compressed form.
 var placementsConv = &SGFConverter{
-	Props: []Prop{"AB", "AW"},
+	Props: []Prop{"AB", "AW", "AE"},
 	Scope: AllScope,
 	From: func(n *movetree.Node, prop string, data []string) error {
 		col, err := color.FromSGFProp(prop)
@@ -28,32 +31,31 @@ var placementsConv = &SGFConverter{
 		if len(n.Placements) == 0 {
 			return "", nil
 		}
-		var black []string
-		var white []string
+		pts := make(map[color.Color][]*point.Point)
 		for _, mv := range n.Placements {
-			sgfPt, err := mv.Point().ToSGF()
+			pts[mv.Color()] = append(pts[mv.Color()], mv.Point())
+		}
+		var out strings.Builder
+		for _, p := range []struct {
+			prop string
+			col  color.Color
+		}{
+			{"AB", color.Black},
+			{"AW", color.White},
+			{"AE", color.Empty},
+		} {
+			if len(pts[p.col]) == 0 {
+				continue
+			}
+			sgfPts, err := point.ListToSGF(pts[p.col])
 			if err != nil {
 				return "", err
 			}
-			if mv.Color() == color.Black {
-				black = append(black, sgfPt)
-			} else if mv.Color() == color.White {
-				white = append(white, sgfPt)
+			out.WriteString(p.prop)
+			for _, pt := range sgfPts {
+				out.WriteString("[" + pt + "]")
 			}
 		}
-		var out strings.Builder
-		if len(black) > 0 {
-			out.WriteString("AB")
-		}
-		for _, pt := range black {
-			out.WriteString("[" + pt + "]")
-		}
-		if len(white) > 0 {
-			out.WriteString("AW")
-		}
-		for _, pt := range white {
-			out.WriteString("[" + pt + "]")
-		}
 		return out.String(), nil
 	},
 }
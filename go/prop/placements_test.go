@@ -33,6 +33,36 @@ func TestConvertFromSGF_Placements(t *testing.T) {
 				}
 			},
 		},
+		{
+			desc: "compressed placements",
+			prop: "AB",
+			data: []string{"aa:ba", "cc"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Placements = []*move.Move{
+					move.New(color.Black, point.New(0, 0)),
+					move.New(color.Black, point.New(1, 0)),
+					move.New(color.Black, point.New(2, 2)),
+				}
+			},
+		},
+		{
+			desc: "cleared points",
+			prop: "AE",
+			data: []string{"aa", "bb"},
+			makeExpNode: func(n *movetree.Node) {
+				n.Placements = []*move.Move{
+					move.New(color.Empty, point.New(0, 0)),
+					move.New(color.Empty, point.New(1, 1)),
+				}
+			},
+		},
+		{
+			desc:        "reversed rectangle",
+			prop:        "AW",
+			data:        []string{"bb:aa"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      point.SGFConversionErr,
+		},
 	}
 
 	testConvertFromSGFCases(t, testCases)
@@ -60,6 +90,29 @@ func TestConvertNode_Placements(t *testing.T) {
 			},
 			expOut: "AW[ab][ac]",
 		},
+		{
+			desc: "compressed placements",
+			makeNode: func(n *movetree.Node) {
+				n.Placements = []*move.Move{
+					move.New(color.White, point.New(2, 2)),
+					move.New(color.Black, point.New(1, 1)),
+					move.New(color.Black, point.New(0, 0)),
+					move.New(color.Black, point.New(1, 0)),
+					move.New(color.Black, point.New(0, 1)),
+				}
+			},
+			expOut: "AB[aa:bb]AW[cc]",
+		},
+		{
+			desc: "cleared points",
+			makeNode: func(n *movetree.Node) {
+				n.Placements = []*move.Move{
+					move.New(color.Empty, point.New(3, 3)),
+					move.New(color.Black, point.New(0, 0)),
+				}
+			},
+			expOut: "AB[aa]AE[dd]",
+		},
 	}
 
 	testConvertNodeCases(t, testCases)
@@ -0,0 +1,99 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+var ErrResult = errors.New("error converting result property RE")
+
+// resultConv converts the result property RE.
+var resultConv = &SGFConverter{
+	Props: []Prop{"RE"},
+	Scope: RootScope,
+	From: func(n *movetree.Node, prop string, data []string) error {
+		if len(data) != 1 {
+			return fmt.Errorf("requires exactly 1 value, but had %d: %w", len(data), ErrResult)
+		}
+		res, err := parseResult(data[0])
+		if err != nil {
+			return err
+		}
+		if n.GameInfo == nil {
+			// For safety, make sure to set create gameinfo if it doesn't exist.
+			n.GameInfo = &movetree.GameInfo{}
+		}
+		n.GameInfo.Result = res
+		return nil
+	},
+	To: func(n *movetree.Node) (string, error) {
+		if n.GameInfo == nil || n.GameInfo.Result == nil {
+			return "", nil
+		}
+		res := n.GameInfo.Result
+		switch res.Reason {
+		case movetree.ReasonDraw, movetree.ReasonVoid, movetree.ReasonUnknown:
+			if res.Winner != color.Empty {
+				return "", fmt.Errorf("result %q can't have a winner, but had %v: %w", res.Reason, res.Winner, ErrResult)
+			}
+		case movetree.ReasonScore, movetree.ReasonResign, movetree.ReasonTime, movetree.ReasonForfeit, movetree.ReasonUnspecified:
+			if res.Winner != color.Black && res.Winner != color.White {
+				return "", fmt.Errorf("winner must be B or W, but was %q: %w", res.Winner, ErrResult)
+			}
+			if res.Reason == movetree.ReasonScore && res.Margin < 0 {
+				return "", fmt.Errorf("margin was %v, but must not be negative: %w", res.Margin, ErrResult)
+			}
+		default:
+			return "", fmt.Errorf("unknown result reason %q: %w", res.Reason, ErrResult)
+		}
+		return "RE[" + res.String() + "]", nil
+	},
+}
+
+// parseResult parses an SGF result, such as B+R, W+3.5, 0 (a draw), Void, or
+// ? (unknown).
+func parseResult(s string) (*movetree.Result, error) {
+	switch s {
+	case "0", "Draw":
+		return &movetree.Result{Reason: movetree.ReasonDraw}, nil
+	case "Void":
+		return &movetree.Result{Reason: movetree.ReasonVoid}, nil
+	case "?":
+		return &movetree.Result{Reason: movetree.ReasonUnknown}, nil
+	}
+	if len(s) < 2 || s[1] != '+' {
+		return nil, fmt.Errorf("invalid result %q: %w", s, ErrResult)
+	}
+	res := &movetree.Result{}
+	switch s[0] {
+	case 'B':
+		res.Winner = color.Black
+	case 'W':
+		res.Winner = color.White
+	default:
+		return nil, fmt.Errorf("invalid winner in result %q: %w", s, ErrResult)
+	}
+
+	switch rest := s[2:]; rest {
+	case "":
+		res.Reason = movetree.ReasonUnspecified
+	case "R", "Resign":
+		res.Reason = movetree.ReasonResign
+	case "T", "Time":
+		res.Reason = movetree.ReasonTime
+	case "F", "Forfeit":
+		res.Reason = movetree.ReasonForfeit
+	default:
+		margin, err := strconv.ParseFloat(rest, 64)
+		if err != nil || margin < 0 {
+			return nil, fmt.Errorf("invalid margin in result %q: %w", s, ErrResult)
+		}
+		res.Reason = movetree.ReasonScore
+		res.Margin = margin
+	}
+	return res, nil
+}
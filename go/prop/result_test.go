@@ -0,0 +1,158 @@
+package prop
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/movetree"
+)
+
+func TestConvertFromSGF_Result(t *testing.T) {
+	testCases := []fromSGFTestCase{
+		{
+			desc: "Win by points",
+			prop: "RE",
+			data: []string{"W+3.5"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.White, Reason: movetree.ReasonScore, Margin: 3.5},
+				}
+			},
+		},
+		{
+			desc: "Win by resignation",
+			prop: "RE",
+			data: []string{"B+Resign"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.Black, Reason: movetree.ReasonResign},
+				}
+			},
+		},
+		{
+			desc: "Win on time",
+			prop: "RE",
+			data: []string{"W+T"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.White, Reason: movetree.ReasonTime},
+				}
+			},
+		},
+		{
+			desc: "Win by forfeit",
+			prop: "RE",
+			data: []string{"B+F"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.Black, Reason: movetree.ReasonForfeit},
+				}
+			},
+		},
+		{
+			desc: "Win, unspecified",
+			prop: "RE",
+			data: []string{"B+"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.Black, Reason: movetree.ReasonUnspecified},
+				}
+			},
+		},
+		{
+			desc: "Draw",
+			prop: "RE",
+			data: []string{"0"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Reason: movetree.ReasonDraw},
+				}
+			},
+		},
+		{
+			desc: "Unknown",
+			prop: "RE",
+			data: []string{"?"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Reason: movetree.ReasonUnknown},
+				}
+			},
+		},
+		{
+			desc:        "Bad winner",
+			prop:        "RE",
+			data:        []string{"X+R"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrResult,
+		},
+		{
+			desc:        "Bad margin",
+			prop:        "RE",
+			data:        []string{"B+lots"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrResult,
+		},
+		{
+			desc:        "Free-form result",
+			prop:        "RE",
+			data:        []string{"Black won"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrResult,
+		},
+	}
+
+	testConvertFromSGFCases(t, testCases)
+}
+
+func TestConvertNode_Result(t *testing.T) {
+	testCases := []convertNodeTestCase{
+		{
+			desc: "Win by points",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.Black, Reason: movetree.ReasonScore, Margin: 12},
+				}
+			},
+			expOut: "RE[B+12]",
+		},
+		{
+			desc: "Win by resignation",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.White, Reason: movetree.ReasonResign},
+				}
+			},
+			expOut: "RE[W+R]",
+		},
+		{
+			desc: "Void",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Reason: movetree.ReasonVoid},
+				}
+			},
+			expOut: "RE[Void]",
+		},
+		{
+			desc: "Win without a winner",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Reason: movetree.ReasonResign},
+				}
+			},
+			expErr: ErrResult,
+		},
+		{
+			desc: "Draw with a winner",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Result: &movetree.Result{Winner: color.Black, Reason: movetree.ReasonDraw},
+				}
+			},
+			expErr: ErrResult,
+		},
+	}
+
+	testConvertNodeCases(t, testCases)
+}
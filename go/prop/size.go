@@ -4,13 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/otrego/clamshell/go/movetree"
 )
 
 var ErrSize = errors.New("error converting size property SZ")
 
-// sizeConv converts the size property SZ.
+// sizeConv converts the size property SZ. Square boards have the form SZ[19]
+// and rectangular boards have the form SZ[<width>:<height>], e.g. SZ[19:13].
 var sizeConv = &SGFConverter{
 	Props: []Prop{"SZ"},
 	Scope: RootScope,
@@ -18,18 +20,29 @@ var sizeConv = &SGFConverter{
 		if l := len(data); l != 1 {
 			return fmt.Errorf("data must be exactly 1, was %d: %w", l, ErrSize)
 		}
-		sz, err := strconv.Atoi(data[0])
-		if err != nil {
-			return fmt.Errorf("parsing data %v as integer %v: %w", data, err, ErrSize)
+		parts := strings.Split(data[0], ":")
+		if len(parts) > 2 {
+			return fmt.Errorf("data %v must have the form <size> or <width>:<height>: %w", data, ErrSize)
 		}
-		if sz < 1 || sz > 25 {
-			return fmt.Errorf("size was %d, but must be between 1 and 25 %w", sz, ErrSize)
+		var dims []int
+		for _, part := range parts {
+			sz, err := strconv.Atoi(part)
+			if err != nil {
+				return fmt.Errorf("parsing data %v as integer %v: %w", data, err, ErrSize)
+			}
+			if sz < 1 || sz > 25 {
+				return fmt.Errorf("size was %d, but must be between 1 and 25 %w", sz, ErrSize)
+			}
+			dims = append(dims, sz)
 		}
 		if n.GameInfo == nil {
 			// For safety, make sure to set create gameinfo if it doesn't exist.
 			n.GameInfo = &movetree.GameInfo{}
 		}
-		n.GameInfo.Size = sz
+		n.GameInfo.Size = dims[0]
+		if len(dims) == 2 && dims[1] != dims[0] {
+			n.GameInfo.Height = dims[1]
+		}
 		return nil
 	},
 	To: func(n *movetree.Node) (string, error) {
@@ -44,6 +57,13 @@ var sizeConv = &SGFConverter{
 		if sz < 1 || sz > 25 {
 			return "", fmt.Errorf("size was %d but only values between 1 and 25 are allowed: %w", sz, ErrSize)
 		}
-		return "SZ[" + strconv.Itoa(sz) + "]", nil
+		h := n.GameInfo.Height
+		if h == 0 || h == sz {
+			return "SZ[" + strconv.Itoa(sz) + "]", nil
+		}
+		if h < 1 || h > 25 {
+			return "", fmt.Errorf("height was %d but only values between 1 and 25 are allowed: %w", h, ErrSize)
+		}
+		return "SZ[" + strconv.Itoa(sz) + ":" + strconv.Itoa(h) + "]", nil
 	},
 }
@@ -18,6 +18,41 @@ func TestConvertFromSGF_Size(t *testing.T) {
 				}
 			},
 		},
+		{
+			desc: "rectangular size",
+			prop: "SZ",
+			data: []string{"19:13"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Size:   19,
+					Height: 13,
+				}
+			},
+		},
+		{
+			desc: "square size, written as rectangle",
+			prop: "SZ",
+			data: []string{"9:9"},
+			makeExpNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Size: 9,
+				}
+			},
+		},
+		{
+			desc:        "invalid height",
+			prop:        "SZ",
+			data:        []string{"19:30"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrSize,
+		},
+		{
+			desc:        "too many dimensions",
+			prop:        "SZ",
+			data:        []string{"19:13:9"},
+			makeExpNode: func(n *movetree.Node) {},
+			expErr:      ErrSize,
+		},
 	}
 
 	testConvertFromSGFCases(t, testCases)
@@ -41,6 +76,26 @@ func TestConvertNode_Size(t *testing.T) {
 			},
 			expOut: "",
 		},
+		{
+			desc: "rectangular size",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Size:   19,
+					Height: 13,
+				}
+			},
+			expOut: "SZ[19:13]",
+		},
+		{
+			desc: "rectangular size, invalid height",
+			makeNode: func(n *movetree.Node) {
+				n.GameInfo = &movetree.GameInfo{
+					Size:   19,
+					Height: 100,
+				}
+			},
+			expErr: ErrSize,
+		},
 		{
 			desc: "size, invalid",
 			makeNode: func(n *movetree.Node) {
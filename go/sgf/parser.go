@@ -1,6 +1,7 @@
 package sgf
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
@@ -18,9 +19,25 @@ func Parse(s string) (*movetree.MoveTree, error) {
 	return FromString(s).Parse()
 }
 
-// Parser parses SGFs into MoveTree objects.
+// ParseCollection is a convenience helper to parse sgf strings containing a
+// collection of game trees.
+func ParseCollection(s string) ([]*movetree.MoveTree, error) {
+	return FromString(s).ParseCollection()
+}
+
+// Parser parses SGFs into MoveTree objects. An SGF is a collection of one or
+// more game trees, which the parser reads one at a time, so that large
+// collections don't need to be held in memory.
 type Parser struct {
 	rdr io.RuneReader
+
+	// idx, row and col are the position in the input, which is kept across
+	// the game trees of a collection for error messages.
+	idx, row, col int
+
+	// err is the error that stopped the parser, returned on subsequent
+	// calls to Next.
+	err error
 }
 
 // FromString creates a parser from a string.
@@ -28,10 +45,15 @@ func FromString(sgf string) *Parser {
 	return FromReader(strings.NewReader(sgf))
 }
 
-// FromReader creates a parser from a string reader.
-func FromReader(r *strings.Reader) *Parser {
+// FromReader creates a parser from a reader. The reader is buffered, unless it
+// already implements io.RuneReader.
+func FromReader(r io.Reader) *Parser {
+	rr, ok := r.(io.RuneReader)
+	if !ok {
+		rr = bufio.NewReader(r)
+	}
 	return &Parser{
-		rdr: r,
+		rdr: rr,
 	}
 }
 
@@ -139,11 +161,74 @@ func (p parseState) String() string {
 	}
 }
 
-// Parse parses a movetree into a tree of moves, return a movetree or a parsing
-// error.
+// Parse parses an SGF containing a single game tree, return a movetree or a
+// parsing error. It's an error for the SGF to contain more than one game tree;
+// use Next or ParseCollection for collections. If the SGF contains no game
+// tree at all, an empty movetree is returned.
 func (p *Parser) Parse() (*movetree.MoveTree, error) {
+	g, err := p.Next()
+	if errors.Is(err, io.EOF) {
+		return movetree.New(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	if _, err := p.Next(); err == nil {
+		return nil, fmt.Errorf("%w: found more than one game tree; expected a single game", ErrParse)
+	} else if !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return g, nil
+}
+
+// ParseCollection parses all the game trees of an SGF collection, returning
+// the movetrees in order or a parsing error.
+func (p *Parser) ParseCollection() ([]*movetree.MoveTree, error) {
+	var out []*movetree.MoveTree
+	for {
+		g, err := p.Next()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		} else if err != nil {
+			return nil, err
+		}
+		out = append(out, g)
+	}
+}
+
+// Next parses the next game tree of the SGF collection, returning io.EOF when
+// there are no more game trees. After a parsing error, Next keeps returning
+// the same error. For example:
+//
+//	p := sgf.FromReader(f)
+//	for {
+//		g, err := p.Next()
+//		if err == io.EOF {
+//			break
+//		} else if err != nil {
+//			return err
+//		}
+//		// Use g.
+//	}
+func (p *Parser) Next() (*movetree.MoveTree, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	g, err := p.parseGame()
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+	return g, nil
+}
+
+// parseGame parses a single game tree, reading the input only up to the end of
+// the game tree.
+func (p *Parser) parseGame() (*movetree.MoveTree, error) {
 	g := movetree.New()
-	stateData := &stateData{}
+	stateData := &stateData{idx: p.idx, row: p.row, col: p.col}
+	defer func() {
+		p.idx, p.row, p.col = stateData.idx, stateData.row, stateData.col
+	}()
 	pbuf := &propBuffer{}
 
 	// the parser uses a finite state machine to perform parsing, having the
@@ -158,10 +243,18 @@ func (p *Parser) Parse() (*movetree.MoveTree, error) {
 	//               |    V                      ^
 	//               |    --------------'['-------
 	//               V
-	//              END
-	var c rune
-	var err error
-	for c, _, err = p.rdr.ReadRune(); err == nil; c, _, err = p.rdr.ReadRune() {
+	//              END (popBranch of the root)
+	for {
+		c, _, err := p.rdr.ReadRune()
+		if errors.Is(err, io.EOF) {
+			if stateData.curstate == beginningState && len(stateData.branches) == 0 {
+				// Only whitespace remained, so there are no more game trees.
+				return nil, io.EOF
+			}
+			return nil, stateData.parseError("expected to end on root branch, but ended in nested condition")
+		} else if err != nil {
+			return nil, stateData.parseError(fmt.Sprintf("error reading input: %v", err))
+		}
 		stateData.idx++
 		stateData.col++
 		stateData.curchar = c
@@ -196,16 +289,12 @@ func (p *Parser) Parse() (*movetree.MoveTree, error) {
 			return nil, stateData.parseError("unexpected parsing state")
 		}
 		stateData.prevchar = c
-	}
 
-	// We should **always** end with an EOF error
-	if err == nil || !errors.Is(err, io.EOF) {
-		return nil, stateData.parseError(fmt.Sprintf("expected to end on EOF; got %v", err))
-	} else if len(stateData.branches) != 0 {
-		return nil, stateData.parseError("expected to end on root branch, but ended in nested condition")
+		if stateData.curstate == betweenState && len(stateData.branches) == 0 {
+			// The root branch was popped, ending the game tree.
+			return g, nil
+		}
 	}
-
-	return g, nil
 }
 
 // handleBeginning handles the beginning state, initializing the first (root)
@@ -279,7 +368,6 @@ func handleBetween(stateData *stateData, pbuf *propBuffer) error {
 		cn := stateData.curnode
 		stateData.curnode = movetree.NewNode()
 		cn.AddChild(stateData.curnode)
-		stateData.curnode.Parent = cn
 		return nil
 	} else if stateData.curchar == rparen {
 		// AW[aw][bw] (;B[ab])
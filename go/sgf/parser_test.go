@@ -3,8 +3,11 @@ package sgf_test
 import (
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/otrego/clamshell/go/color"
@@ -158,8 +161,6 @@ SQ[ra][rb][rc]
 			pathToProps: map[string]propmap{
 				"-": propmap{
 					"GM": []string{"1"},
-					"SQ": []string{"ra", "rb", "rc"},
-					"PW": []string{"White"},
 				},
 			},
 			pathToNodeCheck: map[string]nodeCheck{
@@ -168,6 +169,22 @@ SQ[ra][rb][rc]
 					if n.GameInfo.Size != expSize {
 						return fmt.Errorf("incorrect size; got %v, but wanted %v", n.GameInfo.Size, expSize)
 					}
+					var squares []*point.Point
+					for _, m := range n.Markup.Marks {
+						if m.Type == movetree.Square {
+							squares = append(squares, m.Point)
+						}
+					}
+					expSquares := []*point.Point{point.New(17, 0), point.New(17, 1), point.New(17, 2)}
+					if !reflect.DeepEqual(squares, expSquares) {
+						return fmt.Errorf("incorrect squares; got %v, but wanted %v", squares, expSquares)
+					}
+					if len(n.Markup.Labels) != 39 {
+						return fmt.Errorf("incorrect number of labels; got %d, but wanted 39", len(n.Markup.Labels))
+					}
+					if n.GameInfo.WhitePlayer != "White" {
+						return fmt.Errorf("incorrect white player; got %q, but wanted %q", n.GameInfo.WhitePlayer, "White")
+					}
 					return nil
 				},
 			},
@@ -278,6 +295,11 @@ AB[na][ra][mb][rb][lc][qc][ld][od][qd][le][pe][qe][mf][nf][of][pg]
 			sgf:    "(;weird[])",
 			expErr: sgf.ErrParse,
 		},
+		{
+			desc:   "error parsing: collection",
+			sgf:    "(;GM[1];B[aa])(;GM[1];B[bb])",
+			expErr: sgf.ErrParse,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -387,3 +409,89 @@ func TestPropertyPostProcessing(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCollection(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		sgf      string
+		expMoves []string
+		expErr   error
+	}{
+		{
+			desc:     "single game",
+			sgf:      "(;GM[1];B[aa])",
+			expMoves: []string{"aa"},
+		},
+		{
+			desc:     "collection",
+			sgf:      "(;GM[1];B[aa])\n(;GM[1];B[bb](;W[cc])(;W[dd]))  (;GM[1];B[ee])\n",
+			expMoves: []string{"aa", "bb", "ee"},
+		},
+		{
+			desc: "empty",
+			sgf:  " \n",
+		},
+		{
+			desc:   "error in second game",
+			sgf:    "(;GM[1];B[aa])(;GM[1];B[bb]",
+			expErr: sgf.ErrParse,
+		},
+		{
+			desc:   "garbage between games",
+			sgf:    "(;GM[1];B[aa]) banana (;GM[1];B[bb])",
+			expErr: sgf.ErrParse,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Use a reader that isn't an io.RuneReader, to check that the input is
+			// buffered.
+			games, err := sgf.FromReader(iotest.OneByteReader(strings.NewReader(tc.sgf))).ParseCollection()
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got err %v, but expected %v", err, tc.expErr)
+			}
+			if err != nil {
+				return
+			}
+			var moves []string
+			for _, g := range games {
+				pt, err := g.Root.Next(0).Move.Point().ToSGF()
+				if err != nil {
+					t.Fatal(err)
+				}
+				moves = append(moves, pt)
+			}
+			if !cmp.Equal(moves, tc.expMoves) {
+				t.Errorf("got first moves %v, but expected %v", moves, tc.expMoves)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	p := sgf.FromString("(;GM[1]C[first])(;GM[1]C[second])(;C)(;GM[1]C[fourth])")
+
+	for _, exp := range []string{"first", "second"} {
+		g, err := p.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.Root.Comment != exp {
+			t.Errorf("got comment %q, but expected %q", g.Root.Comment, exp)
+		}
+	}
+	// Errors stop the parser.
+	for i := 0; i < 2; i++ {
+		if _, err := p.Next(); !errors.Is(err, sgf.ErrParse) {
+			t.Errorf("got err %v, but expected %v", err, sgf.ErrParse)
+		}
+	}
+
+	p = sgf.FromString("(;GM[1])")
+	if _, err := p.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Next(); err != io.EOF {
+		t.Errorf("got err %v at the end of the collection, but expected %v", err, io.EOF)
+	}
+}
@@ -2,6 +2,7 @@ package sgf_test
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -113,3 +114,31 @@ SQ[ra][rb][rc]
 		})
 	}
 }
+
+func TestSerialize_GameInfo(t *testing.T) {
+	g, err := sgf.Parse(`(;GM[1]FF[4]SZ[19]KM[6.5]HA[2]PL[W]AB[pd][dp]
+		PB[Black \] player]PW[White]BR[2k]WR[5d]DT[2020-08-05,06]RE[W+R]
+		RU[Japanese]TM[259200]OT[86400 fischer]GN[Test]EV[League]RO[3]PC[Online];W[dd])`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialized, err := sgf.Serialize(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := sgf.Parse(serialized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Root.GameInfo, g.Root.GameInfo) {
+		t.Errorf("got game info %+v after serializing, expected %+v", got.Root.GameInfo, g.Root.GameInfo)
+	}
+	if got.Root.GameInfo.BlackPlayer != "Black ] player" {
+		t.Errorf("got black player %q, expected %q", got.Root.GameInfo.BlackPlayer, "Black ] player")
+	}
+	for _, p := range []string{"PB", "DT", "RE", "RU", "TM", "OT", "PC"} {
+		if _, ok := got.Root.SGFProperties[p]; ok {
+			t.Errorf("got untyped property %s, expected it to be converted", p)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package symmetry
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+)
+
+// Canonical returns the canonical form of the board among the given
+// candidate symmetries (typically Dihedral or All), along with the symmetry
+// that produces it. Boards that are equivalent under the candidate symmetries
+// have identical canonical forms, which makes them useful for deduplicating
+// positions.
+//
+// The canonical form is the transformed board that's smallest when comparing
+// the dimensions and then the points row by row, where Black < White < Empty.
+// Ties, which happen for symmetric positions, are broken by the smallest
+// Hash, so that the ko point and the player to move are canonical too, and the
+// Hash of the canonical form can be used as a key. Candidates that produce the
+// same board are broken by their order.
+func Canonical(b *board.Board, candidates []Symmetry) (*board.Board, Symmetry) {
+	best := b
+	bestSym := Symmetry{}
+	var bestState [][]color.Color
+	for i, s := range candidates {
+		tb := s.Board(b)
+		state := tb.FullBoardState()
+		c := compareStates(state, bestState)
+		if i == 0 || c < 0 || (c == 0 && tb.Hash() < best.Hash()) {
+			best, bestSym, bestState = tb, s, state
+		}
+	}
+	return best, bestSym
+}
+
+// CanonicalMoves returns the canonical form of a move sequence on a
+// width x height board among the given candidate symmetries, along with the
+// symmetry that produces it. This is useful for indexing openings
+// independent of orientation.
+//
+// The canonical form is the transformed sequence that's smallest when
+// comparing the moves in order, by point (x, then y, with passes first) and
+// then by color. Ties are broken by the order of the candidates.
+func CanonicalMoves(ml move.List, width, height int, candidates []Symmetry) (move.List, Symmetry) {
+	best := ml
+	bestSym := Symmetry{}
+	for i, s := range candidates {
+		tl := s.MoveList(ml, width, height)
+		if i == 0 || compareMoveLists(tl, best) < 0 {
+			best, bestSym = tl, s
+		}
+	}
+	return best, bestSym
+}
+
+// TopRight returns the reflection that moves the stones toward the top-right
+// corner of a width x height board. For example, a corner problem in the
+// bottom-left is rotated 180 degrees. The stones are compared by their
+// distances from the edges, and the board is only reflected along an axis if
+// the stones are strictly closer to the left (or bottom) edge.
+//
+// Only reflections that keep the dimensions of the board are returned, so the
+// result can be used with bbox.CropBoxFromPreset.
+func TopRight(stones move.List, width, height int) Transform {
+	first := true
+	var minX, maxX, minY, maxY int
+	for _, m := range stones {
+		if m.IsPass() {
+			continue
+		}
+		x, y := m.Point().X(), m.Point().Y()
+		if first || x < minX {
+			minX = x
+		}
+		if first || x > maxX {
+			maxX = x
+		}
+		if first || y < minY {
+			minY = y
+		}
+		if first || y > maxY {
+			maxY = y
+		}
+		first = false
+	}
+	if first {
+		return Identity
+	}
+	flipX := minX < width-1-maxX
+	flipY := height-1-maxY < minY
+
+	switch {
+	case flipX && flipY:
+		return Rotate180
+	case flipX:
+		return FlipHorizontal
+	case flipY:
+		return FlipVertical
+	}
+	return Identity
+}
+
+// compareStates compares two board states by their dimensions and then the
+// points row by row.
+func compareStates(a, b [][]color.Color) int {
+	if len(a) != len(b) {
+		return compareInts(len(a), len(b))
+	}
+	if len(a) > 0 && len(a[0]) != len(b[0]) {
+		return compareInts(len(a[0]), len(b[0]))
+	}
+	for y := range a {
+		for x := range a[y] {
+			if c := compareInts(a[y][x].Ordinal(), b[y][x].Ordinal()); c != 0 {
+				return c
+			}
+		}
+	}
+	return 0
+}
+
+// compareMoveLists compares two move lists move by move.
+func compareMoveLists(a, b move.List) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := compareMoves(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(a), len(b))
+}
+
+// compareMoves compares two moves by point (with passes first) and then by
+// color.
+func compareMoves(a, b *move.Move) int {
+	switch {
+	case a.IsPass() && !b.IsPass():
+		return -1
+	case !a.IsPass() && b.IsPass():
+		return 1
+	case !a.IsPass():
+		if c := compareInts(a.Point().X(), b.Point().X()); c != 0 {
+			return c
+		}
+		if c := compareInts(a.Point().Y(), b.Point().Y()); c != 0 {
+			return c
+		}
+	}
+	return compareInts(a.Color().Ordinal(), b.Color().Ordinal())
+}
+
+func compareInts(a, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
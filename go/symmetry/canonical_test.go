@@ -0,0 +1,146 @@
+package symmetry
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestCanonical(t *testing.T) {
+	stones := move.List{
+		move.New(color.Black, point.New(2, 3)),
+		move.New(color.White, point.New(3, 3)),
+		move.New(color.Black, point.New(6, 1)),
+	}
+	b := board.New(9)
+	if err := b.SetPlacements(stones); err != nil {
+		t.Fatal(err)
+	}
+	canon, _ := Canonical(b, Dihedral)
+
+	for _, s := range Dihedral {
+		t.Run(s.String(), func(t *testing.T) {
+			got, gotSym := Canonical(s.Board(b), Dihedral)
+			if got.String() != canon.String() {
+				t.Errorf("got canonical board\n%v\nexpected\n%v", got, canon)
+			}
+			if want := gotSym.Board(s.Board(b)); want.String() != got.String() {
+				t.Errorf("symmetry %v doesn't produce the canonical board", gotSym)
+			}
+		})
+	}
+
+	swapped := Symmetry{SwapColors: true}.Board(b)
+	if got, _ := Canonical(swapped, Dihedral); got.String() == canon.String() {
+		t.Errorf("got the same canonical board for swapped colors without color symmetries")
+	}
+	canonAll, _ := Canonical(b, All)
+	if got, _ := Canonical(swapped, All); got.String() != canonAll.String() {
+		t.Errorf("got canonical board\n%v\nfor swapped colors, expected\n%v", got, canonAll)
+	}
+}
+
+func TestCanonical_SymmetricKo(t *testing.T) {
+	// The stones are symmetric left to right after Black captures at {2,1},
+	// but the ko point {1,1} is only on the left.
+	b := board.New(9)
+	if err := b.SetPlacements(move.List{
+		move.New(color.Black, point.New(1, 0)),
+		move.New(color.Black, point.New(0, 1)),
+		move.New(color.Black, point.New(1, 2)),
+		move.New(color.White, point.New(2, 0)),
+		move.New(color.White, point.New(3, 1)),
+		move.New(color.White, point.New(2, 2)),
+		move.New(color.White, point.New(1, 1)),
+
+		move.New(color.Black, point.New(7, 0)),
+		move.New(color.Black, point.New(8, 1)),
+		move.New(color.Black, point.New(7, 2)),
+		move.New(color.Black, point.New(6, 1)),
+		move.New(color.White, point.New(6, 0)),
+		move.New(color.White, point.New(5, 1)),
+		move.New(color.White, point.New(6, 2)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.PlaceStone(move.New(color.Black, point.New(2, 1))); err != nil {
+		t.Fatal(err)
+	}
+	if b.Ko() == nil {
+		t.Fatal("expected a ko point after the capture")
+	}
+	canon, _ := Canonical(b, Dihedral)
+
+	for _, s := range Dihedral {
+		t.Run(s.String(), func(t *testing.T) {
+			got, _ := Canonical(s.Board(b), Dihedral)
+			if got.Hash() != canon.Hash() {
+				t.Errorf("got canonical board with ko %v, expected ko %v", got.Ko(), canon.Ko())
+			}
+		})
+	}
+}
+
+func TestCanonicalMoves(t *testing.T) {
+	opening := move.List{
+		move.New(color.Black, point.New(15, 3)),
+		move.New(color.White, point.New(3, 15)),
+		move.New(color.Black, point.New(16, 15)),
+	}
+	canon, _ := CanonicalMoves(opening, 19, 19, Dihedral)
+	for _, s := range Dihedral {
+		t.Run(s.String(), func(t *testing.T) {
+			got, _ := CanonicalMoves(s.MoveList(opening, 19, 19), 19, 19, Dihedral)
+			if !reflect.DeepEqual(got, canon) {
+				t.Errorf("got %v, expected %v", got, canon)
+			}
+		})
+	}
+}
+
+func TestTopRight(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		stones move.List
+		exp    Transform
+	}{
+		{
+			desc: "no stones",
+			exp:  Identity,
+		},
+		{
+			desc:   "top right",
+			stones: move.List{move.New(color.Black, point.New(16, 2))},
+			exp:    Identity,
+		},
+		{
+			desc:   "top left",
+			stones: move.List{move.New(color.Black, point.New(2, 2))},
+			exp:    FlipHorizontal,
+		},
+		{
+			desc:   "bottom right",
+			stones: move.List{move.New(color.Black, point.New(16, 16))},
+			exp:    FlipVertical,
+		},
+		{
+			desc: "bottom left",
+			stones: move.List{
+				move.New(color.Black, point.New(2, 16)),
+				move.New(color.White, point.New(3, 17)),
+			},
+			exp: Rotate180,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := TopRight(tc.stones, 19, 19); got != tc.exp {
+				t.Errorf("got %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Package symmetry contains the symmetries of a go board: the eight rotations
+// and reflections (the dihedral group), each optionally combined with swapping
+// the colors of the stones.
+//
+// Following the SGF convention, the top-left point is 0,0, x increases to the
+// right, and y increases downward.
+package symmetry
+
+import (
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// Transform is a rotation or reflection of the board.
+type Transform int
+
+const (
+	// Identity leaves the board unchanged.
+	Identity Transform = iota
+
+	// Rotate90 rotates the board 90 degrees clockwise.
+	Rotate90
+
+	// Rotate180 rotates the board 180 degrees.
+	Rotate180
+
+	// Rotate270 rotates the board 270 degrees clockwise (90 degrees
+	// counter-clockwise).
+	Rotate270
+
+	// FlipHorizontal reflects the board left-to-right.
+	FlipHorizontal
+
+	// FlipVertical reflects the board top-to-bottom.
+	FlipVertical
+
+	// Transpose reflects the board across the diagonal from the top-left to
+	// the bottom-right.
+	Transpose
+
+	// AntiTranspose reflects the board across the diagonal from the top-right
+	// to the bottom-left.
+	AntiTranspose
+)
+
+// Transforms contains all eight rotations and reflections.
+var Transforms = []Transform{
+	Identity,
+	Rotate90,
+	Rotate180,
+	Rotate270,
+	FlipHorizontal,
+	FlipVertical,
+	Transpose,
+	AntiTranspose,
+}
+
+var transformNames = map[Transform]string{
+	Identity:       "identity",
+	Rotate90:       "rotate90",
+	Rotate180:      "rotate180",
+	Rotate270:      "rotate270",
+	FlipHorizontal: "flip-horizontal",
+	FlipVertical:   "flip-vertical",
+	Transpose:      "transpose",
+	AntiTranspose:  "anti-transpose",
+}
+
+// String returns the name of the transform.
+func (t Transform) String() string {
+	if s, ok := transformNames[t]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// Inverse returns the transform that undoes t.
+func (t Transform) Inverse() Transform {
+	switch t {
+	case Rotate90:
+		return Rotate270
+	case Rotate270:
+		return Rotate90
+	}
+	return t
+}
+
+// swapsAxes indicates whether the transform exchanges the width and height.
+func (t Transform) swapsAxes() bool {
+	switch t {
+	case Rotate90, Rotate270, Transpose, AntiTranspose:
+		return true
+	}
+	return false
+}
+
+// Dimensions returns the width and height of a width x height board after the
+// transform. Rotating or transposing a rectangular board swaps its
+// dimensions.
+func (t Transform) Dimensions(width, height int) (int, int) {
+	if t.swapsAxes() {
+		return height, width
+	}
+	return width, height
+}
+
+// Point returns the point that pt moves to when the transform is applied to a
+// width x height board. A nil point (as for a pass) stays nil.
+func (t Transform) Point(pt *point.Point, width, height int) *point.Point {
+	if pt == nil {
+		return nil
+	}
+	x, y := pt.X(), pt.Y()
+	switch t {
+	case Rotate90:
+		return point.New(height-1-y, x)
+	case Rotate180:
+		return point.New(width-1-x, height-1-y)
+	case Rotate270:
+		return point.New(y, width-1-x)
+	case FlipHorizontal:
+		return point.New(width-1-x, y)
+	case FlipVertical:
+		return point.New(x, height-1-y)
+	case Transpose:
+		return point.New(y, x)
+	case AntiTranspose:
+		return point.New(height-1-y, width-1-x)
+	}
+	return point.New(x, y)
+}
+
+// Symmetry is a rotation or reflection of the board, optionally combined with
+// swapping the colors of the stones.
+type Symmetry struct {
+	Transform Transform
+
+	// SwapColors indicates whether Black and White are exchanged.
+	SwapColors bool
+}
+
+// Dihedral contains the eight rotations and reflections, without swapping
+// colors.
+var Dihedral = symmetries(false)
+
+// All contains the sixteen symmetries: the eight rotations and reflections,
+// each with and without swapping colors.
+var All = append(symmetries(false), symmetries(true)...)
+
+func symmetries(swapColors bool) []Symmetry {
+	out := make([]Symmetry, len(Transforms))
+	for i, t := range Transforms {
+		out[i] = Symmetry{Transform: t, SwapColors: swapColors}
+	}
+	return out
+}
+
+// String returns the name of the symmetry. For example:
+//
+//	rotate90
+//	flip-vertical+swap
+func (s Symmetry) String() string {
+	if s.SwapColors {
+		return s.Transform.String() + "+swap"
+	}
+	return s.Transform.String()
+}
+
+// Inverse returns the symmetry that undoes s.
+func (s Symmetry) Inverse() Symmetry {
+	return Symmetry{Transform: s.Transform.Inverse(), SwapColors: s.SwapColors}
+}
+
+// Color returns the color c after the symmetry is applied.
+func (s Symmetry) Color(c color.Color) color.Color {
+	if s.SwapColors {
+		return c.Opposite()
+	}
+	return c
+}
+
+// Point returns the point that pt moves to on a width x height board.
+func (s Symmetry) Point(pt *point.Point, width, height int) *point.Point {
+	return s.Transform.Point(pt, width, height)
+}
+
+// Move returns the move m after the symmetry is applied on a width x height
+// board. Passes stay passes.
+func (s Symmetry) Move(m *move.Move, width, height int) *move.Move {
+	if m.IsPass() {
+		return move.NewPass(s.Color(m.Color()))
+	}
+	return move.New(s.Color(m.Color()), s.Point(m.Point(), width, height))
+}
+
+// MoveList returns the moves in ml after the symmetry is applied on a
+// width x height board, in the same order.
+func (s Symmetry) MoveList(ml move.List, width, height int) move.List {
+	if ml == nil {
+		return nil
+	}
+	out := make(move.List, len(ml))
+	for i, m := range ml {
+		out[i] = s.Move(m, width, height)
+	}
+	return out
+}
+
+// Board returns a new board with the symmetry applied to b. See
+// board.Board.Transform for the state that's carried over.
+func (s Symmetry) Board(b *board.Board) *board.Board {
+	w, h := b.Width(), b.Height()
+	nw, nh := s.Transform.Dimensions(w, h)
+	return b.Transform(nw, nh, func(pt *point.Point) *point.Point {
+		return s.Point(pt, w, h)
+	}, s.SwapColors)
+}
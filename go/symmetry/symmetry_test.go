@@ -0,0 +1,152 @@
+package symmetry
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestTransform_Point(t *testing.T) {
+	// A 3x2 board, with pt at (0, 1):
+	//
+	//	[. . .]
+	//	[x . .]
+	pt := point.New(0, 1)
+	testCases := []struct {
+		tr        Transform
+		exp       *point.Point
+		expWidth  int
+		expHeight int
+	}{
+		{tr: Identity, exp: point.New(0, 1), expWidth: 3, expHeight: 2},
+		{tr: Rotate90, exp: point.New(0, 0), expWidth: 2, expHeight: 3},
+		{tr: Rotate180, exp: point.New(2, 0), expWidth: 3, expHeight: 2},
+		{tr: Rotate270, exp: point.New(1, 2), expWidth: 2, expHeight: 3},
+		{tr: FlipHorizontal, exp: point.New(2, 1), expWidth: 3, expHeight: 2},
+		{tr: FlipVertical, exp: point.New(0, 0), expWidth: 3, expHeight: 2},
+		{tr: Transpose, exp: point.New(1, 0), expWidth: 2, expHeight: 3},
+		{tr: AntiTranspose, exp: point.New(0, 2), expWidth: 2, expHeight: 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tr.String(), func(t *testing.T) {
+			got := tc.tr.Point(pt, 3, 2)
+			if !got.Equal(tc.exp) {
+				t.Errorf("got point %v, expected %v", got, tc.exp)
+			}
+			w, h := tc.tr.Dimensions(3, 2)
+			if w != tc.expWidth || h != tc.expHeight {
+				t.Errorf("got dimensions %dx%d, expected %dx%d", w, h, tc.expWidth, tc.expHeight)
+			}
+		})
+	}
+}
+
+func TestTransform_Inverse(t *testing.T) {
+	for _, tr := range Transforms {
+		t.Run(tr.String(), func(t *testing.T) {
+			w, h := tr.Dimensions(5, 3)
+			for x := 0; x < 5; x++ {
+				for y := 0; y < 3; y++ {
+					pt := point.New(x, y)
+					got := tr.Inverse().Point(tr.Point(pt, 5, 3), w, h)
+					if !got.Equal(pt) {
+						t.Errorf("got %v after the inverse transform, expected %v", got, pt)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSymmetry_MoveList(t *testing.T) {
+	ml := move.List{
+		move.New(color.Black, point.New(0, 0)),
+		move.NewPass(color.White),
+		move.New(color.Black, point.New(2, 1)),
+	}
+	testCases := []struct {
+		desc string
+		s    Symmetry
+		exp  move.List
+	}{
+		{
+			desc: "rotate90",
+			s:    Symmetry{Transform: Rotate90},
+			exp: move.List{
+				move.New(color.Black, point.New(8, 0)),
+				move.NewPass(color.White),
+				move.New(color.Black, point.New(7, 2)),
+			},
+		},
+		{
+			desc: "swap colors",
+			s:    Symmetry{Transform: Identity, SwapColors: true},
+			exp: move.List{
+				move.New(color.White, point.New(0, 0)),
+				move.NewPass(color.Black),
+				move.New(color.White, point.New(2, 1)),
+			},
+		},
+		{
+			desc: "flip-vertical with swap",
+			s:    Symmetry{Transform: FlipVertical, SwapColors: true},
+			exp: move.List{
+				move.New(color.White, point.New(0, 8)),
+				move.NewPass(color.Black),
+				move.New(color.White, point.New(2, 7)),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.s.MoveList(ml, 9, 9)
+			if !reflect.DeepEqual(got, tc.exp) {
+				t.Errorf("got %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+}
+
+func TestSymmetry_Board(t *testing.T) {
+	b := board.NewRect(3, 2)
+	if err := b.SetPlacements(move.List{
+		move.New(color.Black, point.New(0, 0)),
+		move.New(color.White, point.New(2, 1)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	b.SetToPlay(color.Black)
+
+	got := Symmetry{Transform: Rotate90, SwapColors: true}.Board(b)
+	exp := "[. W]\n" +
+		"[. .]\n" +
+		"[B .]"
+	if got.String() != exp {
+		t.Errorf("got board\n%v\nexpected\n%v", got, exp)
+	}
+	if got.ToPlay() != color.White {
+		t.Errorf("got to play %v, expected %v", got.ToPlay(), color.White)
+	}
+
+	// Applying every symmetry and then its inverse gives back the original
+	// position.
+	for _, s := range All {
+		back := s.Inverse().Board(s.Board(b))
+		if back.Hash() != b.Hash() || back.String() != b.String() {
+			t.Errorf("%v: got board\n%v\nafter the inverse, expected\n%v", s, back, b)
+		}
+	}
+}
+
+func TestSymmetry_String(t *testing.T) {
+	if got := (Symmetry{Transform: FlipVertical, SwapColors: true}).String(); got != "flip-vertical+swap" {
+		t.Errorf("got %q, expected %q", got, "flip-vertical+swap")
+	}
+	if len(Dihedral) != 8 || len(All) != 16 {
+		t.Errorf("got %d dihedral and %d total symmetries, expected 8 and 16", len(Dihedral), len(All))
+	}
+}
@@ -0,0 +1,221 @@
+package symmetry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// pointListProps are the raw SGF properties whose values are points or
+// compressed point lists (rectangles of the form aa:cc).
+var pointListProps = map[string]bool{
+	"TB": true,
+	"TW": true,
+	"VW": true,
+}
+
+// colorSwappedProps are the raw SGF properties that are exchanged when the
+// colors are swapped.
+var colorSwappedProps = map[string]string{
+	"TB": "TW",
+	"TW": "TB",
+}
+
+// MoveTree returns a copy of the movetree with the symmetry applied to the
+// moves, placements, markup, and evaluations of every node. The raw SGF
+// properties that contain points (such as territory) are transformed as well,
+// and the dimensions in the GameInfo are swapped for rotations of rectangular
+// boards.
+//
+// When swapping colors, the player to move and the territory (TB, TW) are
+// swapped, but properties describing the players (such as PB and PW) are left
+// as is.
+func (s Symmetry) MoveTree(mt *movetree.MoveTree) (*movetree.MoveTree, error) {
+	w, h := mt.Root.GameInfo.Dimensions()
+	root, err := s.node(mt.Root, w, h)
+	if err != nil {
+		return nil, err
+	}
+
+	type pair struct{ from, to *movetree.Node }
+	queue := []pair{{mt.Root, root}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, c := range cur.from.Children {
+			nc, err := s.node(c, w, h)
+			if err != nil {
+				return nil, err
+			}
+			cur.to.AddChild(nc)
+			queue = append(queue, pair{c, nc})
+		}
+	}
+	return &movetree.MoveTree{Root: root}, nil
+}
+
+// node returns a copy of node n (without its children) with the symmetry
+// applied, for a width x height board.
+func (s Symmetry) node(n *movetree.Node, width, height int) (*movetree.Node, error) {
+	nn := movetree.NewNode()
+	if n.Move != nil {
+		nn.Move = s.Move(n.Move, width, height)
+	}
+	nn.Placements = s.MoveList(n.Placements, width, height)
+	nn.Comment = n.Comment
+	nn.Markup = s.Markup(n.Markup, width, height)
+	nn.Evaluation = s.Evaluation(n.Evaluation)
+	nn.CopyAnnotations(n)
+
+	if n.GameInfo != nil {
+		gi := n.GameInfo.Clone()
+		nw, nh := s.Transform.Dimensions(width, height)
+		gi.Size = nw
+		gi.Height = 0
+		if nh != nw {
+			gi.Height = nh
+		}
+		if n.GameInfo.Size == 0 && nh == nw {
+			// Keep an unspecified size unspecified.
+			gi.Size = 0
+		}
+		gi.Player = s.Color(gi.Player)
+		nn.GameInfo = gi
+	}
+
+	for prop, vals := range n.SGFProperties {
+		out := make([]string, len(vals))
+		for i, v := range vals {
+			var err error
+			out[i], err = s.propValue(prop, v, width, height)
+			if err != nil {
+				return nil, fmt.Errorf("transforming property %s[%s]: %w", prop, v, err)
+			}
+		}
+		if swapped, ok := colorSwappedProps[prop]; ok && s.SwapColors {
+			prop = swapped
+		}
+		nn.SGFProperties[prop] = out
+	}
+	return nn, nil
+}
+
+// propValue returns the value v of the raw SGF property prop with the
+// symmetry applied. Values of properties that don't contain points are
+// returned as is.
+func (s Symmetry) propValue(prop, v string, width, height int) (string, error) {
+	switch {
+	case pointListProps[prop]:
+		parts := strings.Split(v, ":")
+		if len(parts) == 1 {
+			return s.sgfPoint(v, width, height)
+		}
+		if len(parts) != 2 {
+			return "", fmt.Errorf("malformed point list %q", v)
+		}
+		return s.sgfRect(parts[0], parts[1], width, height)
+	}
+	return v, nil
+}
+
+// Markup returns a copy of the markup with the symmetry applied, for a width
+// x height board.
+func (s Symmetry) Markup(m movetree.Markup, width, height int) movetree.Markup {
+	var out movetree.Markup
+	for _, mk := range m.Marks {
+		out.Marks = append(out.Marks, &movetree.Mark{Point: s.Point(mk.Point, width, height), Type: mk.Type})
+	}
+	for _, l := range m.Labels {
+		out.Labels = append(out.Labels, &movetree.Label{Point: s.Point(l.Point, width, height), Text: l.Text})
+	}
+	line := func(l *movetree.Line) *movetree.Line {
+		return &movetree.Line{From: s.Point(l.From, width, height), To: s.Point(l.To, width, height)}
+	}
+	for _, l := range m.Arrows {
+		out.Arrows = append(out.Arrows, line(l))
+	}
+	for _, l := range m.Lines {
+		out.Lines = append(out.Lines, line(l))
+	}
+	if m.Dimmed != nil {
+		// Keep an empty list of dimmed points, which clears them.
+		out.Dimmed = []*point.Point{}
+	}
+	for _, pt := range m.Dimmed {
+		out.Dimmed = append(out.Dimmed, s.Point(pt, width, height))
+	}
+	for _, pt := range m.Selected {
+		out.Selected = append(out.Selected, s.Point(pt, width, height))
+	}
+	return out
+}
+
+// Evaluation returns the evaluation with the symmetry applied. When swapping
+// colors, positions good for one player become good for the other, and the
+// value is negated.
+func (s Symmetry) Evaluation(ev movetree.Evaluation) movetree.Evaluation {
+	if !s.SwapColors {
+		return ev
+	}
+	switch ev.Position {
+	case movetree.GoodForBlack:
+		ev.Position = movetree.GoodForWhite
+	case movetree.GoodForWhite:
+		ev.Position = movetree.GoodForBlack
+	}
+	if ev.Value != nil {
+		v := -*ev.Value
+		ev.Value = &v
+	}
+	return ev
+}
+
+// sgfPoint transforms a single SGF point.
+func (s Symmetry) sgfPoint(v string, width, height int) (string, error) {
+	pt, err := point.NewFromSGF(v)
+	if err != nil {
+		return "", err
+	}
+	return s.Point(pt, width, height).ToSGF()
+}
+
+// sgfRect transforms a compressed point list given by two opposite corners,
+// returning the new top-left and bottom-right corners.
+func (s Symmetry) sgfRect(a, b string, width, height int) (string, error) {
+	pa, err := point.NewFromSGF(a)
+	if err != nil {
+		return "", err
+	}
+	pb, err := point.NewFromSGF(b)
+	if err != nil {
+		return "", err
+	}
+	ta, tb := s.Point(pa, width, height), s.Point(pb, width, height)
+	tl := point.New(minInt(ta.X(), tb.X()), minInt(ta.Y(), tb.Y()))
+	br := point.New(maxInt(ta.X(), tb.X()), maxInt(ta.Y(), tb.Y()))
+	tls, err := tl.ToSGF()
+	if err != nil {
+		return "", err
+	}
+	brs, err := br.ToSGF()
+	if err != nil {
+		return "", err
+	}
+	return tls + ":" + brs, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,75 @@
+package symmetry
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/sgf"
+)
+
+func TestSymmetry_MoveTree(t *testing.T) {
+	testCases := []struct {
+		desc string
+		s    Symmetry
+		in   string
+		exp  string
+	}{
+		{
+			desc: "flip horizontal",
+			s:    Symmetry{Transform: FlipHorizontal},
+			in:   "(;GM[1]SZ[9]AB[aa]TR[ab:bc];B[cc]LB[cc:A](;W[dd])(;W[ee]))",
+			exp:  "(;GM[1]SZ[9]AB[ia]TR[hb:ic];B[gc]LB[gc:A](;W[fd])(;W[ee]))",
+		},
+		{
+			desc: "rotate rectangular board",
+			s:    Symmetry{Transform: Rotate90},
+			in:   "(;GM[1]SZ[5:3];B[aa];W[ec])",
+			exp:  "(;GM[1]SZ[3:5];B[ca];W[ae])",
+		},
+		{
+			desc: "swap colors",
+			s:    Symmetry{Transform: Identity, SwapColors: true},
+			in:   "(;GM[1]SZ[9]PL[B]AB[aa];W[bb]TB[cc]GB[2]V[3.5])",
+			exp:  "(;GM[1]SZ[9]PL[W]AW[aa];B[bb]TW[cc]GW[2]V[-3.5])",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mt, err := sgf.Parse(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			before, err := sgf.Serialize(mt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tmt, err := tc.s.MoveTree(mt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := sgf.Serialize(tmt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expmt, err := sgf.Parse(tc.exp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			exp, err := sgf.Serialize(expmt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != exp {
+				t.Errorf("got %s, expected %s", got, exp)
+			}
+
+			// The original tree is unchanged.
+			after, err := sgf.Serialize(mt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if after != before {
+				t.Errorf("got original %s after the transform, expected %s", after, before)
+			}
+		})
+	}
+}
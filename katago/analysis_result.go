@@ -6,11 +6,21 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
 )
 
+// ErrInvalidGTPPoint indicates that a move from Katago isn't a valid GTP
+// point.
+var ErrInvalidGTPPoint = errors.New("invalid GTP point")
+
+// AnalysisKey is the key for the AnalysisResult attached to nodes by
+// AddToGame.
+var AnalysisKey = movetree.NewKey[*AnalysisResult]("katago")
+
 // AnalysisResult represents the result of an analysis from katago.
 //
 // For more details, see: https://github.com/lightvector/KataGo/blob/master/docs/Analysis_Engine.md
@@ -20,8 +30,14 @@ type AnalysisResult struct {
 	MoveInfos  []*MoveInfo `json:"moveInfos"`
 	RootInfo   *RootInfo   `json:"rootInfo"`
 
+	// Ownership is the predicted ownership of each point, in [-1,1], in
+	// row-major order starting from the top-left of the board as seen by
+	// Katago. It's only present if the query set IncludeOwnership. Positive
+	// values favor the player given by Katago's reportAnalysisWinratesAs
+	// setting.
+	Ownership []float64 `json:"ownership,omitempty"`
+
 	// Not yet supported:
-	// ownership
 	// policy
 }
 
@@ -103,7 +119,7 @@ func (al AnalysisList) AddToGame(g *movetree.MoveTree) error {
 		if curAn.TurnNumber == curNode.MoveNum() {
 			// Match! Attach the analysis data.
 			// Increment both the node and the analysis list.
-			curNode.SetAnalysisData(curAn)
+			AnalysisKey.Set(curNode, curAn)
 			if next := curNode.Next(0); next != nil {
 				curNode = next
 			} else {
@@ -184,6 +200,50 @@ type MoveInfo struct {
 	// pvVisits
 }
 
+// Point returns the point of the move, or nil if the move is a pass.
+func (mi *MoveInfo) Point() (*point.Point, error) {
+	return pointFromGTP(mi.Move)
+}
+
+// pointFromGTP converts a GTP point, such as D4, back to a point. It's the
+// inverse of movetreeConverter.point, so the row of the GTP point is y+1. A
+// pass is returned as a nil point.
+func pointFromGTP(s string) (*point.Point, error) {
+	up := strings.ToUpper(s)
+	if up == "PASS" {
+		return nil, nil
+	}
+	if len(up) < 2 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidGTPPoint, s)
+	}
+	col := up[0]
+	if col < 'A' || col > 'Z' || col == 'I' {
+		return nil, fmt.Errorf("%w: %q has an invalid column", ErrInvalidGTPPoint, s)
+	}
+	x := int(col - 'A')
+	if col > 'I' {
+		// I is skipped.
+		x--
+	}
+	row, err := strconv.Atoi(up[1:])
+	if err != nil || row < 1 {
+		return nil, fmt.Errorf("%w: %q has an invalid row", ErrInvalidGTPPoint, s)
+	}
+	return point.New(x, row-1), nil
+}
+
+// BestMove returns the move that Katago ranks first, or nil if there are no
+// move infos.
+func (ar *AnalysisResult) BestMove() *MoveInfo {
+	var best *MoveInfo
+	for _, mi := range ar.MoveInfos {
+		if best == nil || mi.Order < best.Order {
+			best = mi
+		}
+	}
+	return best
+}
+
 // RootInfo contains information for the move-at root.
 type RootInfo struct {
 	Winrate       float64 `json:"winrate"`
@@ -2,12 +2,14 @@ package katago
 
 import (
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
 	"github.com/otrego/clamshell/go/sgf"
 )
 
@@ -115,6 +117,57 @@ func Test_Demarshal_MoveInfo_Slice(t *testing.T) {
 	}
 }
 
+func TestMoveInfo_Point(t *testing.T) {
+	testCases := []struct {
+		move   string
+		exp    *point.Point
+		expErr error
+	}{
+		{move: "A1", exp: point.New(0, 0)},
+		{move: "D4", exp: point.New(3, 3)},
+		{move: "J10", exp: point.New(8, 9)},
+		{move: "t19", exp: point.New(18, 18)},
+		{move: "pass"},
+		{move: "I5", expErr: ErrInvalidGTPPoint},
+		{move: "C0", expErr: ErrInvalidGTPPoint},
+		{move: "Q", expErr: ErrInvalidGTPPoint},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.move, func(t *testing.T) {
+			got, err := (&MoveInfo{Move: tc.move}).Point()
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, expected %v", err, tc.expErr)
+			}
+			if (tc.exp == nil && got != nil) || (tc.exp != nil && !tc.exp.Equal(got)) {
+				t.Errorf("got %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+
+	// The conversion is the inverse of the conversion for queries.
+	gc := &movetreeConverter{}
+	for _, pt := range []*point.Point{point.New(7, 2), point.New(8, 2), point.New(18, 0)} {
+		got, err := (&MoveInfo{Move: gc.point(pt)}).Point()
+		if err != nil || !got.Equal(pt) {
+			t.Errorf("got %v, %v for %v, expected the same point", got, err, pt)
+		}
+	}
+}
+
+func TestAnalysisResult_BestMove(t *testing.T) {
+	ar := &AnalysisResult{MoveInfos: []*MoveInfo{
+		{Move: "D4", Order: 1},
+		{Move: "Q16", Order: 0},
+		{Move: "C3", Order: 2},
+	}}
+	if got := ar.BestMove(); got == nil || got.Move != "Q16" {
+		t.Errorf("got best move %v, expected Q16", got)
+	}
+	if got := (&AnalysisResult{}).BestMove(); got != nil {
+		t.Errorf("got best move %v with no move infos, expected nil", got)
+	}
+}
+
 func TestSortResults(t *testing.T) {
 	res := AnalysisList{
 		&AnalysisResult{ID: "foo-3", TurnNumber: 3},
@@ -191,24 +244,19 @@ func TestAddToGame(t *testing.T) {
 				}
 				n := tp.Apply(g.Root)
 
-				ad := n.AnalysisData()
-				if valp == nil && ad == nil {
+				nodeAn, ok := AnalysisKey.Get(n)
+				if valp == nil && !ok {
 					// expected case, but nothing to do.
 					continue
-				} else if valp == nil && ad != nil {
+				} else if valp == nil && ok {
 					t.Errorf("at treepath %q, got analysis data, but expected none", tpRaw)
 					continue
-				} else if valp != nil && ad == nil {
+				} else if valp != nil && !ok {
 					t.Errorf("at treepath %q, got no analysis data, but expected some", tpRaw)
 					continue
 				}
 				val := *valp
 
-				nodeAn, ok := n.AnalysisData().(*AnalysisResult)
-				if !ok {
-					t.Errorf("at treepath %q, attached analysis was of wrong type. Type was %T", tpRaw, nodeAn)
-					continue
-				}
 				if wr := nodeAn.RootInfo.Winrate; wr != val {
 					t.Errorf("at treepath %q, got winrate %f, but expected %f", tpRaw, wr, val)
 				}
@@ -0,0 +1,62 @@
+package katago
+
+import (
+	"fmt"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/deadstone"
+	"github.com/otrego/clamshell/go/point"
+)
+
+// OwnershipEstimator estimates dead stones using the ownership predicted by
+// Katago. A chain is dead when its stones are, on average, predicted to be
+// owned by the opponent.
+type OwnershipEstimator struct {
+	// Result is the analysis of the final position, made with
+	// IncludeOwnership set.
+	Result *AnalysisResult
+
+	// Perspective is the color whose ownership is positive, which is given by
+	// Katago's reportAnalysisWinratesAs setting. If empty, Black is assumed,
+	// which is the setting in Katago's example analysis config.
+	Perspective color.Color
+
+	// Threshold is how strongly the opponent must be predicted to own a chain
+	// for it to be dead, in [0,1].
+	Threshold float64
+}
+
+var _ deadstone.Estimator = (*OwnershipEstimator)(nil)
+
+// NewOwnershipEstimator creates an OwnershipEstimator for an analysis result,
+// reported from Black's perspective.
+func NewOwnershipEstimator(res *AnalysisResult) *OwnershipEstimator {
+	return &OwnershipEstimator{Result: res, Perspective: color.Black}
+}
+
+// DeadStones returns the stones estimated to be dead.
+func (e *OwnershipEstimator) DeadStones(b *board.Board) ([]*point.Point, error) {
+	if e.Result == nil {
+		return nil, fmt.Errorf("%w: no analysis result", ErrInvalidOwnership)
+	}
+	m, err := e.Result.OwnershipMap(b.Width(), b.Height(), e.Perspective)
+	if err != nil {
+		return nil, err
+	}
+
+	var dead []*board.Chain
+	for _, ch := range b.Chains() {
+		var total float64
+		for _, st := range ch.Stones {
+			total += m.At(st)
+		}
+		if ch.Color == color.White {
+			total = -total
+		}
+		if total/float64(len(ch.Stones)) < -e.Threshold {
+			dead = append(dead, ch)
+		}
+	}
+	return deadstone.Stones(dead), nil
+}
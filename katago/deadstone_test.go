@@ -0,0 +1,83 @@
+package katago
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestOwnershipEstimator(t *testing.T) {
+	// The board is:
+	//
+	//   B W .
+	//   B . .
+	b := board.NewRect(3, 2)
+	err := b.SetPlacements(move.List{
+		move.New(color.Black, point.New(0, 0)),
+		move.New(color.Black, point.New(0, 1)),
+		move.New(color.White, point.New(1, 0)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		desc        string
+		ownership   []float64
+		perspective color.Color
+		threshold   float64
+		exp         []*point.Point
+		expErr      error
+	}{
+		{
+			desc:      "white stone owned by black",
+			ownership: []float64{0.9, 0.9, 0.8, 0.9, 0.7, 0.6},
+			exp:       []*point.Point{point.New(1, 0)},
+		},
+		{
+			desc:      "below threshold",
+			ownership: []float64{0.9, 0.9, 0.8, 0.9, 0.3, 0.6},
+			threshold: 0.5,
+		},
+		{
+			desc:        "white perspective",
+			ownership:   []float64{0.9, 0.9, 0.8, 0.9, 0.7, 0.6},
+			perspective: color.White,
+			exp:         []*point.Point{point.New(0, 0), point.New(0, 1)},
+		},
+		{
+			desc:      "rows are ordered from the top",
+			ownership: []float64{0.9, 0.9, 0.9, 0.9, -0.9, 0.9},
+		},
+		{
+			desc:   "no ownership",
+			expErr: ErrInvalidOwnership,
+		},
+		{
+			desc:      "wrong size",
+			ownership: []float64{0.9, 0.9},
+			expErr:    ErrInvalidOwnership,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			e := NewOwnershipEstimator(&AnalysisResult{Ownership: tc.ownership})
+			if tc.perspective != color.Empty {
+				e.Perspective = tc.perspective
+			}
+			e.Threshold = tc.threshold
+			got, err := e.DeadStones(b)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("got error %v, expected %v", err, tc.expErr)
+			}
+			if !reflect.DeepEqual(got, tc.exp) {
+				t.Errorf("got dead stones %v, expected %v", got, tc.exp)
+			}
+		})
+	}
+}
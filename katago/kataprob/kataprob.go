@@ -7,6 +7,7 @@ import (
 	"math"
 
 	"github.com/golang/glog"
+	"github.com/otrego/clamshell/go/move"
 	"github.com/otrego/clamshell/go/movetree"
 	"github.com/otrego/clamshell/katago"
 )
@@ -15,7 +16,6 @@ import (
 func FindBlunders(g *movetree.MoveTree) ([]movetree.Path, error) {
 	blunderAmt := 3.0
 
-	var cur movetree.Path
 	var found []movetree.Path
 	if g.Root == nil {
 		return found, nil
@@ -29,18 +29,11 @@ func FindBlunders(g *movetree.MoveTree) ([]movetree.Path, error) {
 
 		// We assume alternating moves. Lead is always presented as
 		pl := prevLead
-		cur = append(cur, n.VarNum())
 		glog.V(3).Infof("PrevLead %v\n", prevLead)
 
-		d := n.AnalysisData()
-		if d == nil {
-			glog.Infof("nil analysis data")
-			continue
-		}
-
-		katad, ok := d.(*katago.AnalysisResult)
-		if !ok {
-			glog.V(2).Infof("not analysisResult")
+		katad, ok := katago.AnalysisKey.Get(n)
+		if !ok || katad == nil {
+			glog.Infof("no analysis data")
 			continue
 		}
 		if katad.RootInfo == nil {
@@ -56,7 +49,7 @@ func FindBlunders(g *movetree.MoveTree) ([]movetree.Path, error) {
 		glog.V(3).Infof("Delta: %v:", delta)
 
 		if delta >= math.Abs(blunderAmt) {
-			found = append(found, cur.Clone())
+			found = append(found, n.Path())
 		}
 
 		// prevLead is always with respect to current player
@@ -65,3 +58,75 @@ func FindBlunders(g *movetree.MoveTree) ([]movetree.Path, error) {
 
 	return found, nil
 }
+
+// Annotate sets a move evaluation on the nodes at the given paths, such as
+// the paths returned by FindBlunders, so that they're marked when the game is
+// serialized. The nodes of g are changed in place. An error is returned if a
+// path doesn't exist.
+func Annotate(g *movetree.MoveTree, paths []movetree.Path, eval movetree.MoveEval, em movetree.Emphasis) error {
+	for _, p := range paths {
+		n, err := p.Find(g.Root)
+		if err != nil {
+			return err
+		}
+		n.Evaluation.Move = eval
+		n.Evaluation.MoveEmphasis = em
+	}
+	return nil
+}
+
+// AddBestMoves marks Katago's best move in place of each of the moves at the
+// given paths, such as the paths returned by FindBlunders, as a tesuji (TE).
+// The best move comes from the analysis of the previous position, and is added
+// as the last variation unless it's already one of the variations. Paths whose
+// previous position has no analysis are skipped, and nothing is marked if the
+// move at the path is the best move. The nodes of g are changed in place. An
+// error is returned if a path doesn't exist.
+func AddBestMoves(g *movetree.MoveTree, paths []movetree.Path) error {
+	for _, p := range paths {
+		n, err := p.Find(g.Root)
+		if err != nil {
+			return err
+		}
+		if n.Parent == nil || n.Move == nil {
+			continue
+		}
+		katad, ok := katago.AnalysisKey.Get(n.Parent)
+		if !ok || katad == nil || katad.BestMove() == nil {
+			continue
+		}
+		pt, err := katad.BestMove().Point()
+		if err != nil {
+			return err
+		}
+		best := move.New(n.Move.Color(), pt)
+		if sameMove(best, n.Move) {
+			continue
+		}
+
+		var bn *movetree.Node
+		for _, c := range n.Parent.Children {
+			if c.Move != nil && sameMove(c.Move, best) {
+				bn = c
+				break
+			}
+		}
+		if bn == nil {
+			bn = movetree.NewNode()
+			bn.Move = best
+			n.Parent.AddChild(bn)
+		}
+		bn.Evaluation.Move = movetree.Tesuji
+		bn.Evaluation.MoveEmphasis = movetree.Normal
+	}
+	return nil
+}
+
+// sameMove indicates whether two moves have the same color and point, where
+// passes have no point.
+func sameMove(a, b *move.Move) bool {
+	if a.Color() != b.Color() || a.IsPass() != b.IsPass() {
+		return false
+	}
+	return a.IsPass() || a.Point().Equal(b.Point())
+}
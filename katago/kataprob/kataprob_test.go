@@ -0,0 +1,77 @@
+package kataprob
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/sgf"
+	"github.com/otrego/clamshell/katago"
+)
+
+func TestAnnotate(t *testing.T) {
+	g, err := sgf.Parse("(;GM[1];B[aa];W[bb];B[cc])")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []movetree.Path{{0, 0}, {0, 0, 0}}
+	if err := Annotate(g, paths, movetree.BadMove, movetree.Normal); err != nil {
+		t.Fatal(err)
+	}
+	bad := g.Root.Filter(movetree.HasMoveEval(movetree.BadMove))
+	if len(bad) != 2 || bad[0].MoveNum() != 2 || bad[1].MoveNum() != 3 {
+		t.Errorf("got bad moves %v, expected moves 2 and 3", bad)
+	}
+	out, err := sgf.Serialize(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "(;SZ[19]CA[UTF-8]FF[4]GM[1];B[aa];W[bb]BM[1];B[cc]BM[1])"; out != exp {
+		t.Errorf("got %s, expected %s", out, exp)
+	}
+
+	err = Annotate(g, []movetree.Path{{0, 1}}, movetree.Tesuji, movetree.Normal)
+	if !errors.Is(err, movetree.ErrApplyTreepath) {
+		t.Errorf("got error %v for a missing path, expected %v", err, movetree.ErrApplyTreepath)
+	}
+}
+
+func TestAddBestMoves(t *testing.T) {
+	g, err := sgf.Parse("(;GM[1];B[aa];W[bb];B[cc](;W[ee])(;W[ff]))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	setBest := func(p movetree.Path, gtp string) {
+		n, err := p.Find(g.Root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		katago.AnalysisKey.Set(n, &katago.AnalysisResult{MoveInfos: []*katago.MoveInfo{
+			{Move: "A1", Order: 1},
+			{Move: gtp, Order: 0},
+		}})
+	}
+	// W[bb] is a blunder, where D4 was best.
+	setBest(movetree.Path{0}, "D4")
+	// B[cc] is the best move, so it isn't marked.
+	setBest(movetree.Path{0, 0}, "C3")
+	// W[ee] is a blunder, and the best move W[ff] is already a variation.
+	setBest(movetree.Path{0, 0, 0}, "F6")
+
+	paths := []movetree.Path{{0, 0}, {0, 0, 0}, {0, 0, 0, 0}}
+	if err := AddBestMoves(g, paths); err != nil {
+		t.Fatal(err)
+	}
+	out, err := sgf.Serialize(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "(;SZ[19]CA[UTF-8]FF[4]GM[1];B[aa](;W[bb];B[cc](;W[ee])(;W[ff]TE[1]))(;W[dd]TE[1]))"; out != exp {
+		t.Errorf("got %s, expected %s", out, exp)
+	}
+
+	err = AddBestMoves(g, []movetree.Path{{0, 2}})
+	if !errors.Is(err, movetree.ErrApplyTreepath) {
+		t.Errorf("got error %v for a missing path, expected %v", err, movetree.ErrApplyTreepath)
+	}
+}
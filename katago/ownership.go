@@ -0,0 +1,54 @@
+package katago
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/influence"
+)
+
+// ErrInvalidOwnership indicates that an analysis result has no ownership, or
+// ownership that doesn't match the board.
+var ErrInvalidOwnership = errors.New("invalid ownership")
+
+// OwnershipMap converts the ownership into an influence map, where Black is
+// positive. Perspective is the color whose ownership is positive in the
+// result; if empty, Black is assumed.
+//
+// Katago orders the ownership from its top-left (A19, on a 19x19 board).
+// Since points are converted to GTP with row y+1, the Katago row for y is
+// height-1-y.
+func (ar *AnalysisResult) OwnershipMap(width, height int, perspective color.Color) (influence.Map, error) {
+	if len(ar.Ownership) == 0 {
+		return nil, fmt.Errorf("%w: analysis result has no ownership", ErrInvalidOwnership)
+	}
+	if len(ar.Ownership) != width*height {
+		return nil, fmt.Errorf("%w: got %d values for a %dx%d board", ErrInvalidOwnership, len(ar.Ownership), width, height)
+	}
+	m := influence.New(width, height)
+	for y := range m {
+		for x := range m[y] {
+			v := ar.Ownership[(height-1-y)*width+x]
+			if perspective == color.White {
+				v = -v
+			}
+			m[y][x] = v
+		}
+	}
+	return m, nil
+}
+
+// Ownership returns the ownership of the board position, using the analysis
+// result if it includes ownership (reported from Black's perspective). If it
+// doesn't, or if ar is nil, it falls back to the much cheaper
+// influence.Territory estimate.
+func Ownership(b *board.Board, ar *AnalysisResult) influence.Map {
+	if ar != nil {
+		if m, err := ar.OwnershipMap(b.Width(), b.Height(), color.Black); err == nil {
+			return m
+		}
+	}
+	return influence.Territory(b)
+}
@@ -0,0 +1,72 @@
+package katago
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
+	"github.com/otrego/clamshell/go/move"
+	"github.com/otrego/clamshell/go/point"
+)
+
+func TestParseAnalysis_Ownership(t *testing.T) {
+	res, err := ParseAnalysis(`{"id":"foo","turnNumber":3,"ownership":[0.5,-0.25]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []float64{0.5, -0.25}
+	if !reflect.DeepEqual(res.Ownership, exp) {
+		t.Errorf("got ownership %v, expected %v", res.Ownership, exp)
+	}
+}
+
+func TestOwnershipMap(t *testing.T) {
+	ar := &AnalysisResult{Ownership: []float64{0.1, 0.2, 0.3, -0.4, -0.5, -0.6}}
+	m, err := ar.OwnershipMap(3, 2, color.Black)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first row from Katago is the bottom row of the board.
+	exp := [][]float64{
+		{-0.4, -0.5, -0.6},
+		{0.1, 0.2, 0.3},
+	}
+	if !reflect.DeepEqual([][]float64(m), exp) {
+		t.Errorf("got %v, expected %v", m, exp)
+	}
+
+	m, err = ar.OwnershipMap(3, 2, color.White)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := m.At(point.New(0, 0)); v != 0.4 {
+		t.Errorf("got %v from White's perspective, expected 0.4", v)
+	}
+
+	if _, err := ar.OwnershipMap(2, 2, color.Black); err == nil {
+		t.Errorf("got no error for a mismatched board size, expected error")
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	b := board.NewRect(3, 3)
+	if err := b.SetPlacements(move.List{move.New(color.White, point.New(1, 1))}); err != nil {
+		t.Fatal(err)
+	}
+
+	ar := &AnalysisResult{Ownership: make([]float64, 9)}
+	ar.Ownership[0] = 1
+	m := Ownership(b, ar)
+	if v := m.At(point.New(0, 2)); v != 1 {
+		t.Errorf("got %v from the analysis ownership, expected 1", v)
+	}
+
+	// Without ownership, the influence estimate is used.
+	for _, ar := range []*AnalysisResult{nil, {}} {
+		m := Ownership(b, ar)
+		if v := m.At(point.New(1, 1)); v != -1 {
+			t.Errorf("got %v for the white stone, expected -1", v)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/color"
 	"github.com/otrego/clamshell/go/move"
 	"github.com/otrego/clamshell/go/movetree"
 	"github.com/otrego/clamshell/go/point"
@@ -42,7 +44,7 @@ type Query struct {
 	// BoardXSize is the width of the board
 	BoardXSize int `json:"boardXSize,omitempty"`
 
-	// BoardXSize is the width of the board
+	// BoardYSize is the height of the board
 	BoardYSize int `json:"boardYSize,omitempty"`
 
 	// AnalyzeTurns is the turns of the game to analyze. If this field is not
@@ -56,12 +58,15 @@ type Query struct {
 
 	OverrideSettings map[string]interface{} `json:"overrideSettings,omitempty"`
 
+	// IncludeOwnership requests the predicted ownership of each point, which
+	// is returned in AnalysisResult.Ownership.
+	IncludeOwnership bool `json:"includeOwnership,omitempty"`
+
 	// Not yet supported options
 	// See: https://github.com/lightvector/KataGo/blob/master/docs/Analysis_Engine.md
 	// whiteHandicapBonus
 	// rootPolicyTemperature
 	// rootFpuReductionMax
-	// includeOwnership
 	// includePolicy
 	// includePVVisits
 	// avoidMoves
@@ -98,6 +103,13 @@ const (
 	Japanese Rules = "japanese"
 )
 
+// BoardRules returns the board rules used for checking move legality under the
+// rule-alias. Unknown rule-aliases use simple ko. See
+// movetree.GameInfo.BoardRules.
+func (r Rules) BoardRules() board.Rules {
+	return (&movetree.GameInfo{Rules: string(r)}).BoardRules()
+}
+
 // ToJSON converts a query to JSON.
 func (q *Query) ToJSON() ([]byte, error) {
 	return json.Marshal(q)
@@ -132,20 +144,24 @@ func (gc *movetreeConverter) move(mv *move.Move) Move {
 }
 
 // initialStones converts the initial placements (e.g., handicap stones) into
-// the initial stones for the analysis.
+// the initial stones for the analysis. Cleared points (AE) are skipped, since
+// the board starts out empty.
 func (gc *movetreeConverter) initialStones() []Move {
 	var out []Move
 	for _, mv := range gc.g.Root.Placements {
+		if mv.Color() == color.Empty {
+			continue
+		}
 		out = append(out, gc.move(mv))
 	}
 	return out
 }
 
-// initialPlayer sets the initial player. By default, katago assumes
-// black-to-play, so this isn't necessary.
+// initialPlayer sets the initial player from the game info (PL). By default,
+// katago assumes black-to-play, so this isn't necessary.
 func (gc *movetreeConverter) initialPlayer() string {
-	if val, ok := gc.g.Root.SGFProperties["PL"]; ok && len(val) > 0 {
-		return val[0]
+	if gi := gc.g.Root.GameInfo; gi != nil && gi.Player != color.Empty {
+		return string(gi.Player)
 	}
 	return ""
 }
@@ -172,8 +188,8 @@ func (gc *movetreeConverter) mainBranchMoves(startFrom, maxMove int) []Move {
 
 // rules gets the relevant rule-set, returning TrompTaylorRules if not provided.
 func (gc *movetreeConverter) rules() Rules {
-	if val, ok := gc.g.Root.SGFProperties["RU"]; ok && len(val) > 0 {
-		return Rules(val[0])
+	if ru := gc.g.Root.GameInfo.Rules; ru != "" {
+		return Rules(ru)
 	}
 	return TrompTaylorRules
 }
@@ -194,14 +210,10 @@ func (gc *movetreeConverter) komi() (*float64, error) {
 	return nil, nil
 }
 
-// boardSize gets the size of the go board. Only sizes ups to 25 are allowed,
-// but should typically be 19, 13, or 9.
-func (gc *movetreeConverter) boardSize() int {
-	sz := gc.g.Root.GameInfo.Size
-	if sz == 0 {
-		sz = 19
-	}
-	return sz
+// boardSize gets the width and height of the go board. Only sizes ups to 25
+// are allowed, but should typically be 19, 13, or 9.
+func (gc *movetreeConverter) boardSize() (int, int) {
+	return gc.g.Root.GameInfo.Dimensions()
 }
 
 // analyzeMainBranch analyzes the main branch of the movetree.
@@ -291,10 +303,7 @@ func AnalysisQueryFromGame(g *movetree.MoveTree, inOpts *QueryOptions) (*Query,
 	}
 	q.Komi = km
 
-	sz := gc.boardSize()
-
-	q.BoardYSize = sz
-	q.BoardXSize = sz
+	q.BoardXSize, q.BoardYSize = gc.boardSize()
 	q.OverrideSettings["analysisPVLen"] = strconv.Itoa(*opts.AnalysisDepth)
 	q.AnalyzeTurns = gc.analyzeMainBranch(*opts.StartFrom, *opts.MaxMoves)
 
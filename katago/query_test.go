@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/handicap"
 	"github.com/otrego/clamshell/go/sgf"
 )
 
@@ -98,6 +100,44 @@ func TestCreateAnalysis(t *testing.T) {
 				return q
 			}(),
 		},
+		{
+			desc: "rules",
+			sgf:  "(;GM[1]RU[Japanese])",
+			expQuery: func() *Query {
+				q := defaultQuery()
+				q.Rules = Rules("Japanese")
+				return q
+			}(),
+		},
+		{
+			desc: "rectangular board",
+			sgf:  "(;GM[1]SZ[19:13])",
+			expQuery: func() *Query {
+				q := defaultQuery()
+				q.BoardXSize = 19
+				q.BoardYSize = 13
+				return q
+			}(),
+		},
+		{
+			desc: "handicap stones and initial player",
+			sgf:  "(;GM[1]SZ[9]HA[2]AB[gc][cg]PL[W];W[cc])",
+			expQuery: func() *Query {
+				q := defaultQuery()
+				q.BoardXSize = 9
+				q.BoardYSize = 9
+				q.InitialStones = []Move{
+					Move{"B", "G3"},
+					Move{"B", "C7"},
+				}
+				q.InitialPlayer = "W"
+				q.Moves = []Move{
+					Move{"W", "C3"},
+				}
+				q.AnalyzeTurns = []int{1}
+				return q
+			}(),
+		},
 		{
 			desc: "Analyze some moves: Max moves",
 			sgf:  "(;GM[1];B[aa];W[bb];B[cc];W[dd])",
@@ -151,3 +191,47 @@ func TestCreateAnalysis(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalysisQueryFromGame_Handicap(t *testing.T) {
+	g, err := handicap.NewGame(19, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := AnalysisQueryFromGame(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expStones := []Move{
+		Move{"B", "Q4"},
+		Move{"B", "D16"},
+		Move{"B", "Q16"},
+	}
+	if !cmp.Equal(q.InitialStones, expStones) {
+		t.Errorf("got initial stones %v, expected %v", q.InitialStones, expStones)
+	}
+	if q.InitialPlayer != "W" {
+		t.Errorf("got initial player %q, expected %q", q.InitialPlayer, "W")
+	}
+}
+
+func TestRules_BoardRules(t *testing.T) {
+	testCases := []struct {
+		rules Rules
+		exp   board.Rules
+	}{
+		{rules: TrompTaylorRules, exp: board.Rules{Ko: board.PositionalSuperko, AllowSuicide: true}},
+		{rules: ChineseRules, exp: board.Rules{Ko: board.SimpleKo}},
+		{rules: ChineseOGSRules, exp: board.Rules{Ko: board.PositionalSuperko}},
+		{rules: NewZealandRules, exp: board.Rules{Ko: board.SituationalSuperko, AllowSuicide: true}},
+		{rules: Japanese, exp: board.Rules{Ko: board.SimpleKo}},
+		{rules: Rules("Chinese-KGS"), exp: board.Rules{Ko: board.PositionalSuperko}},
+		{rules: Rules("bga"), exp: board.Rules{Ko: board.SituationalSuperko}},
+		{rules: Rules("AGA"), exp: board.Rules{Ko: board.SituationalSuperko}},
+		{rules: Rules("zork"), exp: board.Rules{Ko: board.SimpleKo}},
+	}
+	for _, tc := range testCases {
+		if got := tc.rules.BoardRules(); got != tc.exp {
+			t.Errorf("Rules(%q).BoardRules()=%v, but expected %v", tc.rules, got, tc.exp)
+		}
+	}
+}
@@ -48,6 +48,8 @@ var (
 	configFlag      = flag.String("config", "", "The analysis config file to use. If not set, looks for env var $KATAGO_ANALYSIS_CONFIG. Example: analysis_example.cfg")
 	analysisThreads = flag.Int("analysis_threads", 8, "The number of analysis threads")
 
+	annotate = flag.Bool("annotate", false, "If set, store a copy of each analyzed game with the positions flagged as blunders marked as bad moves (BM), and Katago's best move in their place added as a tesuji (TE)")
+
 	startFromMove = flag.Int("start_from_move", 0, "Start all games from the specified move number; this is primarily used for debugging")
 	maxMoves      = flag.Int("max_moves_per_game", 0, "Only allow this many moves per game to be analyzed. If specified at zero, analyze the whole game")
 )
@@ -95,8 +97,10 @@ func main() {
 		glog.Exit(err)
 	}
 	proc := &problemProcessor{
-		an: an,
-		fs: store,
+		an:       an,
+		fs:       store,
+		seen:     make(map[uint64]bool),
+		annotate: *annotate,
 	}
 
 	if err = proc.genProblems(files); err != nil {
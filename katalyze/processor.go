@@ -3,7 +3,8 @@ package main
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"os"
 	"path"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/otrego/clamshell/go/movetree"
 	"github.com/otrego/clamshell/go/problems"
 	"github.com/otrego/clamshell/go/sgf"
+	"github.com/otrego/clamshell/go/symmetry"
 	"github.com/otrego/clamshell/katago"
 	"github.com/otrego/clamshell/katago/kataprob"
 	"github.com/otrego/clamshell/storage"
@@ -20,6 +22,16 @@ import (
 type problemProcessor struct {
 	an *katago.Analyzer
 	fs storage.Filestore
+
+	// seen contains the canonical position hashes of the problems generated so
+	// far, so that duplicate positions (including mirror images) are only
+	// stored once.
+	seen map[uint64]bool
+
+	// annotate indicates whether to store a copy of each game with the
+	// blunders marked as bad moves and the best moves marked as tesuji, along
+	// with the analysis.
+	annotate bool
 }
 
 type problem struct {
@@ -36,35 +48,70 @@ func (p *problem) name() string {
 
 // genProblems generates problems.
 func (p *problemProcessor) genProblems(sgfFiles []string) error {
-	ctx := context.Background()
 	for _, file := range sgfFiles {
-		probs, err := p.processGame(file)
-		if err != nil {
-			glog.Warningf("error processing file %v: %v", file, err)
-			continue
-		}
-		for _, pr := range probs {
-			name := pr.name()
-			if err = p.fs.Put(ctx, storage.Problems, name, pr.contents); err != nil {
-				return fmt.Errorf("error putting problem %v with contents %v: %v", name, pr.contents, err)
-			}
+		if err := p.processFile(file); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-// processGame turns one game into a set of problems.
-func (p *problemProcessor) processGame(fi string) ([]*problem, error) {
+// processFile turns each game in an SGF file, which may be a collection of
+// games, into problems and stores them. The games are read one at a time, so
+// large collections aren't loaded whole. Errors with a game are logged and the
+// game is skipped; only errors storing problems are returned.
+func (p *problemProcessor) processFile(fi string) error {
 	glog.Infof("Processing file %q", fi)
 
-	content, err := ioutil.ReadFile(fi)
+	f, err := os.Open(fi)
 	if err != nil {
-		return nil, err
+		glog.Warningf("error processing file %v: %v", fi, err)
+		return nil
 	}
-	g, err := sgf.FromString(string(content)).Parse()
-	if err != nil {
-		return nil, err
+	defer f.Close()
+
+	ctx := context.Background()
+	parser := sgf.FromReader(f)
+	for i := 0; ; i++ {
+		g, err := parser.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			glog.Warningf("error parsing game %d of file %v: %v", i, fi, err)
+			return nil
+		}
+		name := gameName(fi, i)
+		probs, err := p.processGame(name, g)
+		if err != nil {
+			glog.Warningf("error processing game %v: %v", name, err)
+			continue
+		}
+		for _, pr := range probs {
+			probName := pr.name()
+			if err = p.fs.Put(ctx, storage.Problems, probName, pr.contents); err != nil {
+				return fmt.Errorf("error putting problem %v with contents %v: %v", probName, pr.contents, err)
+			}
+		}
+	}
+}
+
+// gameName returns the name of the game at index i of the collection in file
+// fi. The first game keeps the name of the file, so that single-game files are
+// named as before, and the later games add their index, such as games-1.sgf.
+func gameName(fi string, i int) string {
+	base := path.Base(fi)
+	if i == 0 {
+		return base
 	}
+	ext := path.Ext(base)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(base, ext), i, ext)
+}
+
+// processGame turns one game into a set of problems. The name identifies the
+// game in the names of the problems and the stored analysis.
+func (p *problemProcessor) processGame(name string, g *movetree.MoveTree) ([]*problem, error) {
+	glog.Infof("Processing game %q", name)
+
 	q, err := katago.AnalysisQueryFromGame(g, &katago.QueryOptions{
 		MaxMoves:  maxMoves,
 		StartFrom: startFromMove,
@@ -76,13 +123,13 @@ func (p *problemProcessor) processGame(fi string) ([]*problem, error) {
 	if err != nil {
 		return nil, err
 	}
-	glog.Infof("Finished processing file %q", fi)
+	glog.Infof("Finished processing game %q", name)
 	glog.V(2).Infof("Processing data: %v\n", result)
 
 	if err = result.AddToGame(g); err != nil {
 		return nil, err
 	}
-	glog.Infof("Finished adding to game for file %q", fi)
+	glog.Infof("Finished adding to game %q", name)
 
 	var positions []movetree.Path
 	paths, err := kataprob.FindBlunders(g)
@@ -91,18 +138,35 @@ func (p *problemProcessor) processGame(fi string) ([]*problem, error) {
 	}
 	positions = append(positions, paths...)
 
+	if p.annotate {
+		if err := p.storeAnnotated(name, g, paths); err != nil {
+			return nil, err
+		}
+	}
+
 	var probs []*problem
 	for _, pos := range positions {
+		b, err := problems.PopulateBoard(pos, g)
+		if err != nil {
+			return nil, fmt.Errorf("error populating board for game %v at position %v: %v", name, pos.CompactString(), err)
+		}
+		cb, _ := symmetry.Canonical(b, symmetry.Dihedral)
+		if p.seen[cb.Hash()] {
+			glog.V(2).Infof("Skipping duplicate problem for game %v at position %v", name, pos.CompactString())
+			continue
+		}
+		p.seen[cb.Hash()] = true
+
 		mt, err := problems.Flatten(pos, g)
 		if err != nil {
-			return nil, fmt.Errorf("error flattening game %v at position %v: %v", fi, pos.CompactString(), err)
+			return nil, fmt.Errorf("error flattening game %v at position %v: %v", name, pos.CompactString(), err)
 		}
 		s, err := sgf.Serialize(mt)
 		if err != nil {
-			return nil, fmt.Errorf("error serializing game %v at position %v: %v", fi, pos.CompactString(), err)
+			return nil, fmt.Errorf("error serializing game %v at position %v: %v", name, pos.CompactString(), err)
 		}
 		probs = append(probs, &problem{
-			originalFile: path.Base(fi),
+			originalFile: name,
 			path:         pos,
 			mt:           mt,
 			contents:     s,
@@ -111,3 +175,24 @@ func (p *problemProcessor) processGame(fi string) ([]*problem, error) {
 
 	return probs, nil
 }
+
+// storeAnnotated stores a copy of the game with the blunders marked as bad
+// moves (BM) and Katago's best move in their place marked as a tesuji (TE).
+// The game itself is left unchanged, since the problems are built from it.
+func (p *problemProcessor) storeAnnotated(name string, g *movetree.MoveTree, blunders []movetree.Path) error {
+	annotated := g.Clone()
+	if err := kataprob.Annotate(annotated, blunders, movetree.BadMove, movetree.Normal); err != nil {
+		return fmt.Errorf("error annotating game %v: %v", name, err)
+	}
+	if err := kataprob.AddBestMoves(annotated, blunders); err != nil {
+		return fmt.Errorf("error adding best moves to game %v: %v", name, err)
+	}
+	s, err := sgf.Serialize(annotated)
+	if err != nil {
+		return fmt.Errorf("error serializing annotated game %v: %v", name, err)
+	}
+	if err := p.fs.Put(context.Background(), storage.Analysis, name, s); err != nil {
+		return fmt.Errorf("error putting annotated game %v: %v", name, err)
+	}
+	return nil
+}
@@ -1,23 +1,40 @@
 package snapshot
 
 import (
+	"fmt"
+
 	"github.com/otrego/clamshell/go/bbox"
 	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/influence"
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
 	"github.com/otrego/clamshell/snapshot/symbol"
 )
 
-// createBoard creates a Board snapshot from some board state
-func createBoard(b *board.Board, cbox *bbox.CropBox) (*Board, error) {
+// createBoard creates a Board snapshot from some board state. Intersections
+// are indexed by row (y), then column (x), relative to the crop box. If heat
+// is non-nil, it must have the same dimensions as the board.
+func createBoard(b *board.Board, cbox *bbox.CropBox, heat influence.Map) (*Board, error) {
+	if heat != nil {
+		if w, h := heat.Dimensions(); w != b.Width() || h != b.Height() {
+			return nil, fmt.Errorf("heat map is %dx%d, but the board is %dx%d", w, h, b.Width(), b.Height())
+		}
+	}
 	fb := b.FullBoardState()
-	intz := make([][]*Intersection, cbox.BBox.Width())
-	for r := cbox.BBox.Top(); r < cbox.BBox.Height(); r++ {
-		row := fb[r]
-		intz[r] = make([]*Intersection, cbox.BBox.Width())
-		for c := cbox.BBox.Left(); c < cbox.BBox.Width(); c++ {
-			col := row[c]
-			intz[r][c] = &Intersection{
-				Stone: symbol.StoneFromColor(col),
+	bb := cbox.BBox
+	intz := make([][]*Intersection, bb.Height())
+	for y := bb.Top(); y < bb.Bottom(); y++ {
+		row := fb[y]
+		intz[y-bb.Top()] = make([]*Intersection, bb.Width())
+		for x := bb.Left(); x < bb.Right(); x++ {
+			in := &Intersection{
+				Point: point.New(x, y),
+				Stone: symbol.StoneFromColor(row[x]),
 			}
+			if heat != nil {
+				in.Heat = heat[y][x]
+			}
+			intz[y-bb.Top()][x-bb.Left()] = in
 		}
 	}
 	return &Board{
@@ -26,6 +43,57 @@ func createBoard(b *board.Board, cbox *bbox.CropBox) (*Board, error) {
 	}, nil
 }
 
+// markSymbols are the symbols for each type of mark.
+var markSymbols = map[movetree.MarkType]symbol.Symbol{
+	movetree.Triangle: symbol.Triangle,
+	movetree.Square:   symbol.Square,
+	movetree.Circle:   symbol.Circle,
+	movetree.XMark:    symbol.Xmark,
+}
+
+// addMarkup adds the markup for a node to the board. Dimmed points carry over
+// from earlier nodes, so they're taken from the closest node (starting with n
+// and going up to the root) that sets them.
+func (sb *Board) addMarkup(n *movetree.Node) {
+	for _, m := range n.Markup.Marks {
+		if in := sb.intersection(m.Point); in != nil {
+			in.Mark = markSymbols[m.Type]
+		}
+	}
+	for _, l := range n.Markup.Labels {
+		if in := sb.intersection(l.Point); in != nil {
+			in.Mark = symbol.TextLabel
+			in.Label = l.Text
+		}
+	}
+	for _, pt := range n.Markup.Selected {
+		if in := sb.intersection(pt); in != nil {
+			in.Selected = true
+		}
+	}
+	for cur := n; cur != nil; cur = cur.Parent {
+		if cur.Markup.Dimmed == nil {
+			continue
+		}
+		for _, pt := range cur.Markup.Dimmed {
+			if in := sb.intersection(pt); in != nil {
+				in.Dimmed = true
+			}
+		}
+		break
+	}
+}
+
+// intersection returns the intersection at a point on the whole board,
+// returning nil if it's outside of the crop box.
+func (sb *Board) intersection(pt *point.Point) *Intersection {
+	bb := sb.CropBox.BBox
+	if pt.X() < bb.Left() || pt.X() >= bb.Right() || pt.Y() < bb.Top() || pt.Y() >= bb.Bottom() {
+		return nil
+	}
+	return sb.Intersections[pt.Y()-bb.Top()][pt.X()-bb.Left()]
+}
+
 // Board is a snapshot-board representation. It can be cropped.
 type Board struct {
 	// Intersections contain the intersections for the board.
@@ -25,6 +25,17 @@ type Intersection struct {
 	// Label for the intersection. Label should only be set when Mark == TextLabel
 	// or a similar label-mark.
 	Label string
+
+	// Dimmed indicates that the intersection is dimmed (grayed out).
+	Dimmed bool
+
+	// Selected indicates that the intersection is selected.
+	Selected bool
+
+	// Heat for the intersection, in [-1,1], when the snapshot is created with
+	// a heat map. Positive values favor Black, and negative values favor
+	// White.
+	Heat float64
 }
 
 // TopLayerUnicodeString outputs a single character for the intersection, based
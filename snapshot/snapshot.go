@@ -8,6 +8,7 @@ package snapshot
 import (
 	"github.com/otrego/clamshell/go/bbox"
 	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/influence"
 	"github.com/otrego/clamshell/go/movetree"
 )
 
@@ -15,18 +16,47 @@ import (
 type Options struct {
 	// CropBox allows users to specify a crop-specification.
 	CropBox *bbox.CropBox
+
+	// HeatMap, if set, computes a heat map for the position, which is stored
+	// in the Heat of each intersection. For example, influence.Moyo renders
+	// the frameworks of each player.
+	HeatMap func(*board.Board) influence.Map
 }
 
 // Create a new Snapshot from a given movetree and path.
 func Create(mt *movetree.MoveTree, pos movetree.Path, opts *Options) (*Snapshot, error) {
-	size := mt.Root.GameInfo.Size
+	width, height := mt.Root.GameInfo.Dimensions()
 	n := pos.Apply(mt.Root)
-	_, _, err := pos.ApplyToBoard(mt.Root, board.New(size))
+	b := board.NewRect(width, height)
+	b.SetRules(mt.Root.GameInfo.BoardRules())
+	b, _, err := pos.ApplyToBoard(mt.Root, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var cbox *bbox.CropBox
+	if opts != nil && opts.CropBox != nil {
+		cbox = opts.CropBox
+	} else {
+		cbox, err = bbox.CropBoxFromPresetRect(bbox.All, width, height)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var heat influence.Map
+	if opts != nil && opts.HeatMap != nil {
+		heat = opts.HeatMap(b)
+	}
+	sb, err := createBoard(b, cbox, heat)
 	if err != nil {
 		return nil, err
 	}
+	sb.addMarkup(n)
 	return &Snapshot{
 		Comment: n.Comment,
+		Board:   sb,
+		Arrows:  n.Markup.Arrows,
+		Lines:   n.Markup.Lines,
 	}, nil
 }
 
@@ -38,4 +68,10 @@ type Snapshot struct {
 
 	// Borad contains the symbolic representation of the display of the board.
 	Board *Board
+
+	// Arrows and Lines are drawn between intersections. Their points are
+	// relative to the whole board, not the crop box, and may lie outside of
+	// the crop box.
+	Arrows []*movetree.Line
+	Lines  []*movetree.Line
 }
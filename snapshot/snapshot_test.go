@@ -0,0 +1,126 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/go/bbox"
+	"github.com/otrego/clamshell/go/board"
+	"github.com/otrego/clamshell/go/influence"
+	"github.com/otrego/clamshell/go/movetree"
+	"github.com/otrego/clamshell/go/point"
+	"github.com/otrego/clamshell/go/sgf"
+	"github.com/otrego/clamshell/snapshot/symbol"
+)
+
+func TestCreate_HeatMap(t *testing.T) {
+	mt, err := sgf.Parse("(;GM[1]SZ[5];B[cc];W[ea])")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos := movetree.Path{0, 0}
+
+	snap, err := Create(mt, pos, &Options{HeatMap: influence.Area})
+	if err != nil {
+		t.Fatal(err)
+	}
+	intz := snap.Board.Intersections
+	if in := intz[2][2]; in.Stone != symbol.BlackStone || in.Heat != 1 {
+		t.Errorf("got stone %v with heat %v at {2,2}, expected a black stone with heat 1", in.Stone, in.Heat)
+	}
+	if in := intz[0][4]; in.Stone != symbol.WhiteStone || in.Heat != -1 {
+		t.Errorf("got stone %v with heat %v at {4,0}, expected a white stone with heat -1", in.Stone, in.Heat)
+	}
+	if in := intz[4][0]; in.Heat <= 0 {
+		t.Errorf("got heat %v at {0,4}, expected black influence", in.Heat)
+	}
+
+	// Without a heat map, the heat is zero.
+	snap, err = Create(mt, pos, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in := snap.Board.Intersections[4][0]; in.Heat != 0 {
+		t.Errorf("got heat %v without a heat map, expected 0", in.Heat)
+	}
+
+	// The heat map must match the board.
+	wrongSize := func(*board.Board) influence.Map { return influence.New(3, 3) }
+	if _, err := Create(mt, pos, &Options{HeatMap: wrongSize}); err == nil {
+		t.Errorf("got no error for a mismatched heat map, expected error")
+	}
+}
+
+func TestCreate_Markup(t *testing.T) {
+	mt, err := sgf.Parse(`(;GM[1]SZ[5]DD[aa:ba]
+		;B[cc]TR[cc]SQ[dd]LB[ab:A]SL[ee]AR[aa:cc]
+		;W[dc]CR[dc]DD[])`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snap, err := Create(mt, movetree.Path{0}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intz := snap.Board.Intersections
+	if in := intz[2][2]; in.Stone != symbol.BlackStone || in.Mark != symbol.Triangle {
+		t.Errorf("got stone %v with mark %v at {2,2}, expected a black stone with a triangle", in.Stone, in.Mark)
+	}
+	if in := intz[3][3]; in.Mark != symbol.Square {
+		t.Errorf("got mark %v at {3,3}, expected a square", in.Mark)
+	}
+	if in := intz[1][0]; in.Mark != symbol.TextLabel || in.Label != "A" {
+		t.Errorf("got mark %v with label %q at {0,1}, expected the label A", in.Mark, in.Label)
+	}
+	if in := intz[4][4]; !in.Selected {
+		t.Errorf("got {4,4} not selected, expected it to be selected")
+	}
+	// The dimmed points carry over from the root.
+	if !intz[0][0].Dimmed || !intz[0][1].Dimmed || intz[1][1].Dimmed {
+		t.Errorf("got dimmed points %v %v %v, expected only {0,0} and {1,0} dimmed", intz[0][0].Dimmed, intz[0][1].Dimmed, intz[1][1].Dimmed)
+	}
+	if len(snap.Arrows) != 1 || !snap.Arrows[0].To.Equal(point.New(2, 2)) {
+		t.Errorf("got arrows %v, expected an arrow to {2,2}", snap.Arrows)
+	}
+
+	// Markup other than dimming applies only to its node, and DD[] clears the
+	// dimmed points.
+	snap, err = Create(mt, movetree.Path{0, 0}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intz = snap.Board.Intersections
+	if in := intz[2][2]; in.Mark != symbol.Empty {
+		t.Errorf("got mark %v at {2,2} on the next move, expected none", in.Mark)
+	}
+	if in := intz[2][3]; in.Mark != symbol.Circle {
+		t.Errorf("got mark %v at {3,2}, expected a circle", in.Mark)
+	}
+	if intz[0][0].Dimmed {
+		t.Errorf("got {0,0} dimmed after DD[], expected it to be cleared")
+	}
+
+	// Markup outside of the crop box is skipped.
+	cbox, err := bbox.CropBoxFromPresetRect(bbox.TopLeft, 5, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap, err = Create(mt, movetree.Path{0}, &Options{CropBox: cbox})
+	if err != nil {
+		t.Fatal(err)
+	}
+	intz = snap.Board.Intersections
+	if len(intz) == 5 {
+		t.Fatalf("got an uncropped board, expected it to be cropped")
+	}
+	if in := intz[2][2]; in.Mark != symbol.Triangle {
+		t.Errorf("got mark %v at {2,2} on the cropped board, expected a triangle", in.Mark)
+	}
+	for _, row := range intz {
+		for _, in := range row {
+			if in.Selected {
+				t.Errorf("got selected point %v on the cropped board, expected none", in.Point)
+			}
+		}
+	}
+}